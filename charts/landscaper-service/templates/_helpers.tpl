@@ -113,6 +113,22 @@ Create the name of the service account to use
 {{- end -}}
 {{- end -}}
 
+{{/*
+Comma separated list of the supported landscaper instance versions.
+*/}}
+{{- define "landscaper-service.supportedLandscaperVersions" -}}
+{{- $component := .Values.landscaperservice.landscaperServiceComponent }}
+{{- if $component.supportedVersions }}
+{{- $versions := list }}
+{{- range $component.supportedVersions }}
+{{- $versions = append $versions .version }}
+{{- end }}
+{{- join "," $versions }}
+{{- else }}
+{{- $component.version }}
+{{- end }}
+{{- end }}
+
 {{- define "landscaper-service-config" -}}
 apiVersion: config.landscaper-service.gardener.cloud/v1alpha1
 kind: LandscaperServiceConfiguration
@@ -132,7 +148,16 @@ crdManagement:
 
 landscaperServiceComponent:
   name:  {{ .Values.landscaperservice.landscaperServiceComponent.name }}
+  {{- if .Values.landscaperservice.landscaperServiceComponent.version }}
   version: {{ .Values.landscaperservice.landscaperServiceComponent.version }}
+  {{- end }}
+  {{- if .Values.landscaperservice.landscaperServiceComponent.supportedVersions }}
+  supportedVersions:
+{{ toYaml .Values.landscaperservice.landscaperServiceComponent.supportedVersions | indent 4 }}
+  {{- end }}
+  {{- if .Values.landscaperservice.landscaperServiceComponent.deprecationPeriod }}
+  deprecationPeriod: {{ .Values.landscaperservice.landscaperServiceComponent.deprecationPeriod }}
+  {{- end }}
   repositoryContext:
 {{ toYaml .Values.landscaperservice.landscaperServiceComponent.repositoryContext | indent 4 }}
 {{- if .Values.landscaperservice.landscaperServiceComponent.registryPullSecrets }}
@@ -146,12 +171,36 @@ availabilityMonitoring:
   selfLandscaperNamespace: {{ ((.Values.landscaperservice.availabilityMonitoring).selfLandscaperNamespace) | default "landscaper" }}
   periodicCheckInterval: {{ ((.Values.landscaperservice.availabilityMonitoring).periodicCheckInterval) | default "1m" }}
   lsHealthCheckTimeout: {{ ((.Values.landscaperservice.availabilityMonitoring).lsHealthCheckTimeout) | default "5m" }}
+  checkConcurrency: {{ ((.Values.landscaperservice.availabilityMonitoring).checkConcurrency) | default 10 }}
+  instanceCheckTimeout: {{ ((.Values.landscaperservice.availabilityMonitoring).instanceCheckTimeout) | default "30s" }}
+  dataPlaneProbeTimeout: {{ ((.Values.landscaperservice.availabilityMonitoring).dataPlaneProbeTimeout) | default "10s" }}
   {{- if (.Values.landscaperservice.availabilityMonitoring).AVSConfiguration }}
   availabilityService:
     url: {{ .Values.landscaperservice.availabilityMonitoring.AVSConfiguration.url}}
     apiKey: {{ .Values.landscaperservice.availabilityMonitoring.AVSConfiguration.apiKey }}
     timeout: {{ .Values.landscaperservice.availabilityMonitoring.AVSConfiguration.timeout | default "30s" }}
   {{- end }}
+  {{- if (.Values.landscaperservice.availabilityMonitoring).uploadRetry }}
+  uploadRetry:
+{{ toYaml .Values.landscaperservice.availabilityMonitoring.uploadRetry | indent 4 }}
+  {{- end }}
+  {{- if (.Values.landscaperservice.availabilityMonitoring).sinks }}
+  sinks:
+{{ toYaml .Values.landscaperservice.availabilityMonitoring.sinks | indent 4 }}
+  {{- end }}
+
+serviceTargetConfigProbe:
+  periodicProbeInterval: {{ ((.Values.landscaperservice.serviceTargetConfigProbe).periodicProbeInterval) | default "1m" }}
+  probeTimeout: {{ ((.Values.landscaperservice.serviceTargetConfigProbe).probeTimeout) | default "10s" }}
+
+instanceKubeconfigs:
+  userKubeconfigSecretNameSuffix: {{ ((.Values.landscaperservice.instanceKubeconfigs).userKubeconfigSecretNameSuffix) | default "user-kubeconfig" }}
+  adminKubeconfigSecretNameSuffix: {{ ((.Values.landscaperservice.instanceKubeconfigs).adminKubeconfigSecretNameSuffix) | default "admin-kubeconfig" }}
+  {{- if (.Values.landscaperservice.instanceKubeconfigs).secretLabels }}
+  secretLabels:
+{{ toYaml .Values.landscaperservice.instanceKubeconfigs.secretLabels | indent 4 }}
+  {{- end }}
+  storeInStatus: {{ ((.Values.landscaperservice.instanceKubeconfigs).storeInStatus) | default false }}
 
 gardenerConfiguration:
 {{ toYaml .Values.landscaperservice.gardener | indent 2 }}
@@ -159,6 +208,17 @@ gardenerConfiguration:
 shootConfiguration:
 {{ toYaml .Values.landscaperservice.shootConfiguration | indent 2 }}
 
+{{- if .Values.landscaperservice.rebalancing }}
+rebalancing:
+  planName: {{ .Values.landscaperservice.rebalancing.planName | default "rebalancing" }}
+  planNamespace: {{ .Release.Namespace }}
+  interval: {{ .Values.landscaperservice.rebalancing.interval | default "1h" }}
+  minScoreImprovement: {{ .Values.landscaperservice.rebalancing.minScoreImprovement | default 20 }}
+  maxMovesPerPlan: {{ .Values.landscaperservice.rebalancing.maxMovesPerPlan | default 10 }}
+  maxConcurrentMigrations: {{ .Values.landscaperservice.rebalancing.maxConcurrentMigrations | default 1 }}
+  migrationInterval: {{ .Values.landscaperservice.rebalancing.migrationInterval | default "10m" }}
+{{- end }}
+
 {{- if .Values.landscaperservice.auditLogConfiguration }}
 auditLogConfig:
   auditLogService:
@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/landscaper-service/pkg/controllers/healthwatcher"
 	instancesctrl "github.com/gardener/landscaper-service/pkg/controllers/instances"
 	landscaperdeploymentsctrl "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments"
+	"github.com/gardener/landscaper-service/pkg/controllers/rebalancing"
 	servicetargetconfigsctrl "github.com/gardener/landscaper-service/pkg/controllers/servicetargetconfigs"
 	"github.com/gardener/landscaper-service/pkg/crdmanager"
 	"github.com/gardener/landscaper-service/pkg/utils"
@@ -98,6 +99,9 @@ func (o *options) run(ctx context.Context) error {
 	if err := avuploader.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
 		return fmt.Errorf("unable to setup avuploader controller: %w", err)
 	}
+	if err := rebalancing.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup rebalancing controller: %w", err)
+	}
 
 	o.Log.Info("starting the controllers")
 	if err := mgr.Start(ctx); err != nil {
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	goflag "flag"
+	"fmt"
 	"os"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,6 +17,7 @@ import (
 
 	configinstall "github.com/gardener/landscaper-service/pkg/apis/config/install"
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 
 	flag "github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -82,5 +84,22 @@ func (o *options) parseConfigurationFile(ctx context.Context) (*v1alpha1.Landsca
 }
 
 func (o *options) validate() error {
+	component := &o.Config.LandscaperServiceComponent
+	supportedVersions, err := versions.ParseSupportedVersions(component.GetSupportedVersions())
+	if err != nil {
+		return fmt.Errorf("invalid supported landscaper service component versions: %w", err)
+	}
+	if len(supportedVersions) == 0 {
+		return fmt.Errorf("no landscaper service component version is configured")
+	}
+	if len(component.Version) != 0 {
+		version, err := versions.ParseVersion(component.Version)
+		if err != nil {
+			return fmt.Errorf("invalid landscaper service component version: %w", err)
+		}
+		if !supportedVersions.Contains(version) {
+			return fmt.Errorf("landscaper service component version %q is not a supported version", component.Version)
+		}
+	}
 	return nil
 }
@@ -80,6 +80,16 @@ func (o *options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to get client: %w", err)
 	}
 
+	if o.schedulingExplanation {
+		schedulingClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+		if err != nil {
+			return fmt.Errorf("unable to get scheduling explanation client: %w", err)
+		}
+		o.log.Info("Enabling scheduling explanation", "path", webhook.SchedulingExplanationPath)
+		webhookServer.Register(webhook.SchedulingExplanationPath, webhook.NewSchedulingExplanationHandler(
+			logging.Wrap(ctrl.Log.WithName("scheduling-explanation")), schedulingClient, scheme, o.webhook.targetSchedulingKey))
+	}
+
 	// create ValidatingWebhookConfiguration and register webhooks, if validation is enabled, delete it otherwise
 	if err := registerWebhooks(ctx, webhookServer, kubeClient, scheme, opts.CertDir, o); err != nil {
 		return fmt.Errorf("unable to register validation webhook: %w", err)
@@ -129,6 +139,7 @@ func registerWebhooks(ctx context.Context,
 		ServiceName:        o.webhook.webhookServiceName,
 		ServiceNamespace:   o.webhook.webhookServiceNamespace,
 		WebhookedResources: o.webhook.enabledWebhooks,
+		SupportedVersions:  o.webhook.supportedVersions,
 	}
 
 	// generate certificates
@@ -13,8 +13,10 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	flag "github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 	"github.com/gardener/landscaper-service/pkg/webhook"
 )
 
@@ -51,6 +53,9 @@ type options struct {
 	webhookServiceNamespaceName string         // webhook service namespace and name in the format <namespace>/<name>
 	webhookServicePort          int32          // port of the webhook service
 	certificatesNamespace       string         // the namespace in which the webhook credentials are being created/updated
+	supportedVersions           string         // lists the supported landscaper instance versions as a comma-separated string
+	schedulingExplanation       bool           // enables the scheduling explanation endpoint
+	targetScheduling            string         // target scheduling namespace and name in the format <namespace>/<name>
 
 	webhook webhookOptions
 }
@@ -62,6 +67,8 @@ type webhookOptions struct {
 	webhookServicePort      int32                                 // port of the webhook service
 	certificatesNamespace   string                                // the certificate namespace
 	enabledWebhooks         []webhook.WebhookedResourceDefinition // which resources should be watched by the webhook
+	supportedVersions       versions.SupportedVersions            // the supported landscaper instance versions
+	targetSchedulingKey     *client.ObjectKey                     // the target scheduling used by the scheduling explanation
 }
 
 // NewOptions returns a new options instance
@@ -77,6 +84,11 @@ func (o *options) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.disabledWebhooks, "disable-webhooks", "", "Specify validation webhooks that should be disabled ('all' to disable validation completely)")
 	fs.StringVar(&o.webhookServiceNamespaceName, "webhook-service", "", "Specify namespace and name of the webhook service (format: <namespace>/<name>)")
 	fs.Int32Var(&o.webhookServicePort, "webhook-service-port", 9443, "Specify the port of the webhook service")
+	fs.StringVar(&o.supportedVersions, "supported-landscaper-versions", "", "Specify the supported landscaper instance versions as a comma-separated list")
+	fs.BoolVar(&o.schedulingExplanation, "enable-scheduling-explanation", false, "Serve the scheduling dry-run explanation for landscaper deployments at "+webhook.SchedulingExplanationPath+
+		" on the webhook server port. The explanation exposes tenant IDs and the capacity and usage of the service target configs,"+
+		" therefore requests must carry the bearer token of a user who is allowed to list servicetargetconfigs")
+	fs.StringVar(&o.targetScheduling, "target-scheduling", "", "Specify namespace and name of the target scheduling used by the scheduling explanation (format: <namespace>/<name>)")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -104,6 +116,17 @@ func (o *options) Complete() error {
 		o.webhook.webhookServiceName = webhookService[1]
 	}
 	o.webhook.certificatesNamespace = getCertificateNamespace(o)
+	if len(o.supportedVersions) != 0 {
+		supportedVersions, err := versions.ParseSupportedVersions(strings.Split(o.supportedVersions, ","))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("--supported-landscaper-versions"), o.supportedVersions, err.Error()))
+		}
+		o.webhook.supportedVersions = supportedVersions
+	}
+	if len(o.targetScheduling) != 0 {
+		targetScheduling := strings.Split(o.targetScheduling, "/")
+		o.webhook.targetSchedulingKey = &client.ObjectKey{Namespace: targetScheduling[0], Name: targetScheduling[1]}
+	}
 	return allErrs.ToAggregate()
 }
 
@@ -134,6 +157,13 @@ func (o *options) validate() error {
 		}
 	}
 
+	if len(o.targetScheduling) != 0 {
+		ts := strings.Split(o.targetScheduling, "/")
+		if len(ts) != 2 || len(ts[0]) == 0 || len(ts[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("--target-scheduling"), o.targetScheduling, "must have the format '<namespace>/<name>'"))
+		}
+	}
+
 	if o.port <= 0 || o.port > math.MaxUint16 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("--port"), o.port, fmt.Sprintf("must be in range [0, %d]", math.MaxUint16)))
 	}
@@ -94,7 +94,7 @@ func (r *ManifestDeployerTestRunner) createVirtualClusterClient(deployment *lssv
 		return nil, fmt.Errorf("failed to get instance for deployment: %w", err)
 	}
 
-	virtualClient, err := util.BuildKubeClientForInstance(instance, test.Scheme())
+	virtualClient, err := util.BuildKubeClientForInstance(r.ctx, r.clusterClients.TestCluster, instance, test.Scheme())
 	if err != nil {
 		return nil, err
 	}
@@ -6,7 +6,6 @@ package tests
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
@@ -166,7 +165,7 @@ func (r *VerifyDeploymentRunner) verifyKubeconfig(instance *lssv1alpha1.Instance
 			return false, err
 		}
 
-		return len(instance.Status.UserKubeconfig) > 0, nil
+		return instance.Status.UserKubeconfigSecretRef != nil && instance.Status.AdminKubeconfigSecretRef != nil, nil
 	}, r.config.SleepTime, r.config.MaxRetries)
 
 	if timeout {
@@ -176,7 +175,7 @@ func (r *VerifyDeploymentRunner) verifyKubeconfig(instance *lssv1alpha1.Instance
 		return fmt.Errorf("error while reading ClusterKubeconfig for instance %q: %w", instance.Name, err)
 	}
 
-	virtualClient, err := util.BuildKubeClientForInstance(instance, test.Scheme())
+	virtualClient, err := util.BuildKubeClientForInstance(r.ctx, r.clusterClients.TestCluster, instance, test.Scheme())
 	if err != nil {
 		return err
 	}
@@ -203,9 +202,9 @@ func (r *VerifyDeploymentRunner) verifyKubeconfig(instance *lssv1alpha1.Instance
 	return nil
 }
 func (r *VerifyDeploymentRunner) verifyOIDCKubeconfig(instance *lssv1alpha1.Instance) error {
-	kubeconfig, err := base64.StdEncoding.DecodeString(instance.Status.UserKubeconfig)
+	kubeconfig, err := util.GetInstanceKubeconfig(r.ctx, r.clusterClients.TestCluster, instance.Status.UserKubeconfigSecretRef)
 	if err != nil {
-		return fmt.Errorf("failed to decode kubeconfig of instance %q: %w", instance.Name, err)
+		return fmt.Errorf("failed to read user kubeconfig of instance %q: %w", instance.Name, err)
 	}
 	clientCfg, err := clientcmd.Load([]byte(kubeconfig))
 	if err != nil {
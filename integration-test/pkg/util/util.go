@@ -7,7 +7,6 @@ package util
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
 	"os"
 	"path"
@@ -457,11 +456,29 @@ func BuildLandscaperContext(ctx context.Context, kclient client.Client, name str
 	return nil
 }
 
+// GetInstanceKubeconfig reads a kubeconfig of an instance from the referenced kubeconfig secret.
+func GetInstanceKubeconfig(ctx context.Context, kclient client.Client, secretRef *lssv1alpha1.SecretReference) ([]byte, error) {
+	if secretRef == nil {
+		return nil, fmt.Errorf("kubeconfig secret reference is not set")
+	}
+
+	secret := &corev1.Secret{}
+	if err := kclient.Get(ctx, secretRef.NamespacedName(), secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig secret %q: %w", secretRef.NamespacedName().String(), err)
+	}
+
+	kubeconfig, ok := secret.Data[secretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig secret %q is missing key %q", secretRef.NamespacedName().String(), secretRef.Key)
+	}
+	return kubeconfig, nil
+}
+
 // BuildKubeClientForInstance builds a kubernetes client for the admin kubeconfig of an instance.
-func BuildKubeClientForInstance(instance *lssv1alpha1.Instance, scheme *runtime.Scheme) (client.Client, error) {
-	kubeconfig, err := base64.StdEncoding.DecodeString(instance.Status.AdminKubeconfig)
+func BuildKubeClientForInstance(ctx context.Context, kclient client.Client, instance *lssv1alpha1.Instance, scheme *runtime.Scheme) (client.Client, error) {
+	kubeconfig, err := GetInstanceKubeconfig(ctx, kclient, instance.Status.AdminKubeconfigSecretRef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode kubeconfig of instance %q: %w", instance.Name, err)
+		return nil, fmt.Errorf("failed to read admin kubeconfig of instance %q: %w", instance.Name, err)
 	}
 
 	client, err := BuildKubeClient(string(kubeconfig), scheme)
@@ -19,6 +19,12 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 func SetDefaults_LandscaperServiceConfiguration(obj *LandscaperServiceConfiguration) {
 	SetDefaults_CrdManagementConfiguration(&obj.CrdManagement)
 	SetDefaults_AvailabilityMonitoringConfiguration(&obj.AvailabilityMonitoring)
+	SetDefaults_ServiceTargetConfigProbeConfiguration(&obj.ServiceTargetConfigProbe)
+	SetDefaults_InstanceKubeconfigsConfiguration(&obj.InstanceKubeconfigs)
+	SetDefaults_LandscaperServiceComponentConfiguration(&obj.LandscaperServiceComponent)
+	if obj.Rebalancing != nil {
+		SetDefaults_RebalancingConfiguration(obj.Rebalancing)
+	}
 }
 
 // SetDefaults_CrdManagementConfiguration sets the defaults for the crd management configuration.
@@ -48,11 +54,104 @@ func SetDefaults_AvailabilityMonitoringConfiguration(obj *AvailabilityMonitoring
 	if obj.LSHealthCheckTimeout.Duration == 0 {
 		obj.LSHealthCheckTimeout.Duration = time.Minute * 5
 	}
+	if obj.CheckConcurrency <= 0 {
+		obj.CheckConcurrency = 10
+	}
+	if obj.InstanceCheckTimeout.Duration == 0 {
+		obj.InstanceCheckTimeout.Duration = time.Second * 30
+	}
+	if obj.DataPlaneProbeTimeout.Duration == 0 {
+		obj.DataPlaneProbeTimeout.Duration = time.Second * 10
+	}
 	if obj.AvailabilityServiceConfiguration != nil {
 		if obj.AvailabilityServiceConfiguration.Timeout == "" {
 			obj.AvailabilityServiceConfiguration.Timeout = "30s"
 		}
 	}
+	if obj.UploadRetry.Attempts <= 0 {
+		obj.UploadRetry.Attempts = 3
+	}
+	if obj.UploadRetry.InitialBackoff.Duration == 0 {
+		obj.UploadRetry.InitialBackoff.Duration = time.Second * 1
+	}
+	if obj.UploadRetry.FailureThreshold <= 0 {
+		obj.UploadRetry.FailureThreshold = 5
+	}
+	if obj.UploadRetry.OpenDuration.Duration == 0 {
+		obj.UploadRetry.OpenDuration.Duration = time.Minute * 5
+	}
+	if obj.UploadRetry.MaxBacklog <= 0 {
+		obj.UploadRetry.MaxBacklog = 60
+	}
+	for i := range obj.Sinks {
+		sink := &obj.Sinks[i]
+		if sink.AVS != nil && sink.AVS.Timeout == "" {
+			sink.AVS.Timeout = "30s"
+		}
+		if sink.Webhook != nil && sink.Webhook.Timeout.Duration == 0 {
+			sink.Webhook.Timeout.Duration = time.Second * 30
+		}
+		if sink.Pushgateway != nil {
+			if sink.Pushgateway.Job == "" {
+				sink.Pushgateway.Job = "landscaper-service-availability"
+			}
+			if sink.Pushgateway.Timeout.Duration == 0 {
+				sink.Pushgateway.Timeout.Duration = time.Second * 30
+			}
+		}
+	}
+}
+
+// SetDefaults_ServiceTargetConfigProbeConfiguration sets the defaults for the service target config probe configuration.
+func SetDefaults_ServiceTargetConfigProbeConfiguration(obj *ServiceTargetConfigProbeConfiguration) {
+	if obj.PeriodicProbeInterval.Duration == 0 {
+		obj.PeriodicProbeInterval.Duration = time.Minute * 1
+	}
+	if obj.ProbeTimeout.Duration == 0 {
+		obj.ProbeTimeout.Duration = time.Second * 10
+	}
+}
+
+// SetDefaults_RebalancingConfiguration sets the defaults for the rebalancing configuration.
+func SetDefaults_RebalancingConfiguration(obj *RebalancingConfiguration) {
+	if obj.PlanName == "" {
+		obj.PlanName = "rebalancing"
+	}
+	if obj.PlanNamespace == "" {
+		obj.PlanNamespace = "laas-system"
+	}
+	if obj.Interval.Duration == 0 {
+		obj.Interval.Duration = time.Hour * 1
+	}
+	if obj.MinScoreImprovement == 0 {
+		obj.MinScoreImprovement = 20
+	}
+	if obj.MaxMovesPerPlan == 0 {
+		obj.MaxMovesPerPlan = 10
+	}
+	if obj.MaxConcurrentMigrations == 0 {
+		obj.MaxConcurrentMigrations = 1
+	}
+	if obj.MigrationInterval.Duration == 0 {
+		obj.MigrationInterval.Duration = time.Minute * 10
+	}
+}
+
+// SetDefaults_InstanceKubeconfigsConfiguration sets the defaults for the instance kubeconfigs configuration.
+func SetDefaults_InstanceKubeconfigsConfiguration(obj *InstanceKubeconfigsConfiguration) {
+	if obj.UserKubeconfigSecretNameSuffix == "" {
+		obj.UserKubeconfigSecretNameSuffix = "user-kubeconfig"
+	}
+	if obj.AdminKubeconfigSecretNameSuffix == "" {
+		obj.AdminKubeconfigSecretNameSuffix = "admin-kubeconfig"
+	}
+}
+
+// SetDefaults_LandscaperServiceComponentConfiguration sets the defaults for the landscaper service component configuration.
+func SetDefaults_LandscaperServiceComponentConfiguration(obj *LandscaperServiceComponentConfiguration) {
+	if obj.DeprecationPeriod.Duration == 0 {
+		obj.DeprecationPeriod.Duration = time.Hour * 24 * 90
+	}
 }
 
 // SetDefaults_ShootConfiguration sets the defaults for the shoot configuration.
@@ -27,6 +27,14 @@ type LandscaperServiceConfiguration struct {
 	// +optional
 	CrdManagement CrdManagementConfiguration `json:"crdManagement,omitempty"`
 
+	// ServiceTargetConfigProbe configures the health probe of the target clusters referenced by ServiceTargetConfigs.
+	// +optional
+	ServiceTargetConfigProbe ServiceTargetConfigProbeConfiguration `json:"serviceTargetConfigProbe,omitempty"`
+
+	// InstanceKubeconfigs configures the secrets in which the kubeconfigs exported for Instances are stored.
+	// +optional
+	InstanceKubeconfigs InstanceKubeconfigsConfiguration `json:"instanceKubeconfigs,omitempty"`
+
 	// LandscaperServiceComponent configures the landscaper component that is used by the landscaper service controller.
 	LandscaperServiceComponent LandscaperServiceComponentConfiguration `json:"landscaperServiceComponent"`
 
@@ -44,6 +52,11 @@ type LandscaperServiceConfiguration struct {
 	// which defines rules how ServiceTargetConfigs are assigned to LandscaperDeployments.
 	// +optional
 	Scheduling *v1alpha1.ObjectReference `json:"scheduling,omitempty"`
+
+	// Rebalancing configures the periodic rebalancing of Instances between ServiceTargetConfigs.
+	// The rebalancing is disabled if not set.
+	// +optional
+	Rebalancing *RebalancingConfiguration `json:"rebalancing,omitempty"`
 }
 
 // AvailabilityMonitoringConfiguration is the configuration for the availability monitoring of the provisioned landscaper
@@ -56,6 +69,15 @@ type AvailabilityMonitoringConfiguration struct {
 	//AvailabilityServiceConfiguration configures an external AVS service
 	AvailabilityServiceConfiguration *AvailabilityServiceConfiguration `json:"availabilityService"`
 
+	//Sinks configures further sinks to which the availability is reported by the AVUploader.
+	// The AvailabilityServiceConfiguration is reported as an additional sink with the name "avs".
+	// +optional
+	Sinks []AvailabilitySinkConfiguration `json:"sinks,omitempty"`
+
+	//UploadRetry configures the retries of the AVUploader for failed reports to the sinks.
+	// +optional
+	UploadRetry UploadRetryConfiguration `json:"uploadRetry,omitempty"`
+
 	//SelfLandscaperNamespace defines the namespace of the landscaper in the core cluster to be monitored
 	SelfLandscaperNamespace string `json:"selfLandscaperNamespace"`
 
@@ -65,6 +87,13 @@ type AvailabilityMonitoringConfiguration struct {
 	// (1) a previously available landscaper is unavailable if no updates occurred
 	// (2) a failed landscaper is reported as failed if it does not become available again
 	LSHealthCheckTimeout v1alpha1.Duration `json:"lsHealthCheckTimeout"`
+	//CheckConcurrency defines, how many instances are checked by the HealthWatcher controller at the same time
+	CheckConcurrency int `json:"checkConcurrency"`
+	//InstanceCheckTimeout defines the timeout for the health check of a single instance,
+	// an instance whose check doesn't finish in time is reported as failed
+	InstanceCheckTimeout v1alpha1.Duration `json:"instanceCheckTimeout"`
+	//DataPlaneProbeTimeout defines the timeout for probing the external data plane cluster of an instance
+	DataPlaneProbeTimeout v1alpha1.Duration `json:"dataPlaneProbeTimeout"`
 }
 
 // AvailabilityServiceConfiguration configures an external AVS service
@@ -75,6 +104,125 @@ type AvailabilityServiceConfiguration struct {
 	ApiKey string `json:"apiKey"`
 	//Timeout is the timeout for the AVS request
 	Timeout string `json:"timeout"`
+	//TenantId restricts the uploaded instances to the instances of a tenant, so that the outages of the tenant
+	// are reported to a separate AVS evaluation. The own landscaper is not reported for a tenant.
+	// +optional
+	TenantId string `json:"tenantId,omitempty"`
+}
+
+// UploadRetryConfiguration configures the retries of failed availability reports.
+type UploadRetryConfiguration struct {
+	// Attempts is the number of attempts of a single report, the backoff between the attempts is doubled every time.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+	// InitialBackoff is the backoff after the first failed attempt of a report.
+	// +optional
+	InitialBackoff v1alpha1.Duration `json:"initialBackoff,omitempty"`
+	// FailureThreshold is the number of consecutive failed reports after which a sink is no longer called
+	// for the OpenDuration, so that an unavailable sink is not flooded with requests.
+	// +optional
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// OpenDuration is the duration for which a sink is not called after the FailureThreshold has been reached.
+	// +optional
+	OpenDuration v1alpha1.Duration `json:"openDuration,omitempty"`
+	// MaxBacklog is the maximum number of health watcher runs which are kept for an AVS sink until they are reported.
+	// If the backlog is full, the oldest runs are dropped.
+	// +optional
+	MaxBacklog int `json:"maxBacklog,omitempty"`
+}
+
+// AvailabilitySinkConfiguration configures a sink to which the availability is reported.
+// Exactly one of the sink types has to be set.
+type AvailabilitySinkConfiguration struct {
+	// Name is the unique name of the sink, which is used to track the last reported status of the sink.
+	Name string `json:"name"`
+	// AVS reports the availability to an AVS service.
+	// +optional
+	AVS *AvailabilityServiceConfiguration `json:"avs,omitempty"`
+	// Webhook posts the availability to an http endpoint.
+	// +optional
+	Webhook *WebhookSinkConfiguration `json:"webhook,omitempty"`
+	// Pushgateway pushes the availability as metrics to a Prometheus Pushgateway.
+	// +optional
+	Pushgateway *PushgatewaySinkConfiguration `json:"pushgateway,omitempty"`
+	// File appends the availability as json lines to a file.
+	// +optional
+	File *FileSinkConfiguration `json:"file,omitempty"`
+}
+
+// WebhookSinkConfiguration configures a sink which posts the availability to an http endpoint.
+type WebhookSinkConfiguration struct {
+	// Url is the full url of the endpoint.
+	Url string `json:"url"`
+	// Headers are additional http headers of the request, for example for the authentication.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// Template is a go template which renders the json body of the request from the availability report.
+	// The report is sent as json if no template is set.
+	// +optional
+	Template string `json:"template,omitempty"`
+	// Timeout is the timeout for the request.
+	// +optional
+	Timeout v1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// PushgatewaySinkConfiguration configures a sink which pushes the availability to a Prometheus Pushgateway.
+type PushgatewaySinkConfiguration struct {
+	// Url is the url of the Pushgateway.
+	Url string `json:"url"`
+	// Job is the job name under which the metrics are pushed.
+	// +optional
+	Job string `json:"job,omitempty"`
+	// Timeout is the timeout for the push.
+	// +optional
+	Timeout v1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// FileSinkConfiguration configures a sink which appends the availability to a file.
+type FileSinkConfiguration struct {
+	// Path is the path of the file, every report is appended as a json line.
+	Path string `json:"path"`
+}
+
+// ServiceTargetConfigProbeConfiguration is the configuration for the health probe of the target clusters
+type ServiceTargetConfigProbeConfiguration struct {
+	// PeriodicProbeInterval defines, how often the target cluster of a ServiceTargetConfig is probed
+	PeriodicProbeInterval v1alpha1.Duration `json:"periodicProbeInterval"`
+	// ProbeTimeout defines the timeout for the requests against the target cluster
+	ProbeTimeout v1alpha1.Duration `json:"probeTimeout"`
+}
+
+// RebalancingConfiguration is the configuration for the rebalancing of Instances between ServiceTargetConfigs
+type RebalancingConfiguration struct {
+	// PlanName is the name of the RebalancePlan in which the moves are proposed
+	PlanName string `json:"planName"`
+	// PlanNamespace is the namespace of the RebalancePlan in which the moves are proposed
+	PlanNamespace string `json:"planNamespace"`
+	// Interval defines, how often the distribution of the Instances is compared with the scheduling
+	Interval v1alpha1.Duration `json:"interval"`
+	// MinScoreImprovement is the minimum improvement of the scheduling score in percent for which an Instance is moved
+	MinScoreImprovement int64 `json:"minScoreImprovement"`
+	// MaxMovesPerPlan is the maximum number of moves which are proposed in a plan
+	MaxMovesPerPlan int `json:"maxMovesPerPlan"`
+	// MaxConcurrentMigrations is the maximum number of Instances which are migrated at the same time
+	MaxConcurrentMigrations int `json:"maxConcurrentMigrations"`
+	// MigrationInterval is the minimum duration between the start of two migrations
+	MigrationInterval v1alpha1.Duration `json:"migrationInterval"`
+}
+
+// InstanceKubeconfigsConfiguration is the configuration for the secrets containing the kubeconfigs of Instances
+type InstanceKubeconfigsConfiguration struct {
+	// UserKubeconfigSecretNameSuffix is appended to the Instance name to build the name of the user kubeconfig secret
+	UserKubeconfigSecretNameSuffix string `json:"userKubeconfigSecretNameSuffix"`
+	// AdminKubeconfigSecretNameSuffix is appended to the Instance name to build the name of the admin kubeconfig secret
+	AdminKubeconfigSecretNameSuffix string `json:"adminKubeconfigSecretNameSuffix"`
+	// SecretLabels are additional labels which are set on the kubeconfig secrets
+	// +optional
+	SecretLabels map[string]string `json:"secretLabels,omitempty"`
+	// StoreInStatus defines whether the kubeconfigs are also written into the Instance status
+	// Deprecated: This option only exists to allow consumers to switch to the kubeconfig secrets and will be removed.
+	// +optional
+	StoreInStatus bool `json:"storeInStatus,omitempty"`
 }
 
 // MetricsConfiguration allows to configure how metrics are exposed
@@ -98,9 +246,21 @@ type LandscaperServiceComponentConfiguration struct {
 	// Name is the component name
 	Name string `json:"name"`
 
-	// Version is the component version
+	// Version is the component version which is installed for instances that don't specify a landscaper version.
+	// If empty, the latest supported version is used.
+	// +optional
 	Version string `json:"version"`
 
+	// SupportedVersions is the list of component versions which are supported by this landscaper service.
+	// For every combination of major and minor version at most one version may be listed.
+	// If empty, Version is the only supported version.
+	// +optional
+	SupportedVersions []SupportedVersion `json:"supportedVersions,omitempty"`
+
+	// DeprecationPeriod is the duration after which a deprecated version may be removed from the supported versions.
+	// +optional
+	DeprecationPeriod v1alpha1.Duration `json:"deprecationPeriod,omitempty"`
+
 	// RepositoryContext specifies the repository context for accessing the landscaper service component.
 	RepositoryContext v1alpha1.AnyJSON `json:"repositoryContext"`
 
@@ -109,6 +269,42 @@ type LandscaperServiceComponentConfiguration struct {
 	RegistryPullSecrets []corev1.SecretReference `json:"registryPullSecrets,omitempty"`
 }
 
+// SupportedVersion is a component version which is supported by the landscaper service.
+type SupportedVersion struct {
+	// Version is the component version.
+	Version string `json:"version"`
+
+	// DeprecatedSince is the time since which this version is deprecated.
+	// It is used to compute the time after which the version may be removed from the supported versions.
+	// +optional
+	DeprecatedSince *metav1.Time `json:"deprecatedSince,omitempty"`
+}
+
+// GetSupportedVersions returns the list of supported component versions.
+func (c *LandscaperServiceComponentConfiguration) GetSupportedVersions() []string {
+	if len(c.SupportedVersions) == 0 {
+		if len(c.Version) == 0 {
+			return nil
+		}
+		return []string{c.Version}
+	}
+	result := make([]string, 0, len(c.SupportedVersions))
+	for _, supported := range c.SupportedVersions {
+		result = append(result, supported.Version)
+	}
+	return result
+}
+
+// GetDeprecatedSince returns the time since which the given version is deprecated, if configured.
+func (c *LandscaperServiceComponentConfiguration) GetDeprecatedSince(version string) *metav1.Time {
+	for _, supported := range c.SupportedVersions {
+		if supported.Version == version {
+			return supported.DeprecatedSince
+		}
+	}
+	return nil
+}
+
 // GardenerConfiguration is the gardener specific configuration required for shoot management.
 type GardenerConfiguration struct {
 	// ServiceAccountKubeconfig is the reference to the secret containing the service account kubeconfig.
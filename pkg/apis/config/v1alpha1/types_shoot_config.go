@@ -23,6 +23,9 @@ type ShootConfiguration struct {
 	// ControlPlane holds general control plane settings.
 	// +optional
 	ControlPlane *ControlPlane `json:"controlPlane,omitempty"`
+	// Hibernation specifies whether the shoot cluster is hibernated.
+	// +optional
+	Hibernation *ShootHibernation `json:"hibernation,omitempty"`
 }
 
 // ShootProviderConfiguration is the shoot provider configuration.
@@ -138,3 +141,9 @@ type HighAvailability struct {
 type FailureTolerance struct {
 	Type string `json:"type"`
 }
+
+// ShootHibernation specifies whether the shoot cluster is hibernated.
+type ShootHibernation struct {
+	// Enabled, if set to true, hibernates the shoot cluster.
+	Enabled bool `json:"enabled"`
+}
@@ -57,8 +57,18 @@ func (in *AvailabilityMonitoringConfiguration) DeepCopyInto(out *AvailabilityMon
 		*out = new(AvailabilityServiceConfiguration)
 		**out = **in
 	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]AvailabilitySinkConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.UploadRetry = in.UploadRetry
 	out.PeriodicCheckInterval = in.PeriodicCheckInterval
 	out.LSHealthCheckTimeout = in.LSHealthCheckTimeout
+	out.InstanceCheckTimeout = in.InstanceCheckTimeout
+	out.DataPlaneProbeTimeout = in.DataPlaneProbeTimeout
 	return
 }
 
@@ -88,6 +98,42 @@ func (in *AvailabilityServiceConfiguration) DeepCopy() *AvailabilityServiceConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilitySinkConfiguration) DeepCopyInto(out *AvailabilitySinkConfiguration) {
+	*out = *in
+	if in.AVS != nil {
+		in, out := &in.AVS, &out.AVS
+		*out = new(AvailabilityServiceConfiguration)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookSinkConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pushgateway != nil {
+		in, out := &in.Pushgateway, &out.Pushgateway
+		*out = new(PushgatewaySinkConfiguration)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSinkConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilitySinkConfiguration.
+func (in *AvailabilitySinkConfiguration) DeepCopy() *AvailabilitySinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilitySinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
@@ -147,6 +193,22 @@ func (in *FailureTolerance) DeepCopy() *FailureTolerance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSinkConfiguration) DeepCopyInto(out *FileSinkConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSinkConfiguration.
+func (in *FileSinkConfiguration) DeepCopy() *FileSinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenerConfiguration) DeepCopyInto(out *GardenerConfiguration) {
 	*out = *in
@@ -181,6 +243,29 @@ func (in *HighAvailability) DeepCopy() *HighAvailability {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceKubeconfigsConfiguration) DeepCopyInto(out *InstanceKubeconfigsConfiguration) {
+	*out = *in
+	if in.SecretLabels != nil {
+		in, out := &in.SecretLabels, &out.SecretLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceKubeconfigsConfiguration.
+func (in *InstanceKubeconfigsConfiguration) DeepCopy() *InstanceKubeconfigsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceKubeconfigsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -202,6 +287,14 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperServiceComponentConfiguration) DeepCopyInto(out *LandscaperServiceComponentConfiguration) {
 	*out = *in
+	if in.SupportedVersions != nil {
+		in, out := &in.SupportedVersions, &out.SupportedVersions
+		*out = make([]SupportedVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.DeprecationPeriod = in.DeprecationPeriod
 	in.RepositoryContext.DeepCopyInto(&out.RepositoryContext)
 	if in.RegistryPullSecrets != nil {
 		in, out := &in.RegistryPullSecrets, &out.RegistryPullSecrets
@@ -232,6 +325,8 @@ func (in *LandscaperServiceConfiguration) DeepCopyInto(out *LandscaperServiceCon
 	}
 	in.AvailabilityMonitoring.DeepCopyInto(&out.AvailabilityMonitoring)
 	in.CrdManagement.DeepCopyInto(&out.CrdManagement)
+	out.ServiceTargetConfigProbe = in.ServiceTargetConfigProbe
+	in.InstanceKubeconfigs.DeepCopyInto(&out.InstanceKubeconfigs)
 	in.LandscaperServiceComponent.DeepCopyInto(&out.LandscaperServiceComponent)
 	out.GardenerConfiguration = in.GardenerConfiguration
 	in.ShootConfiguration.DeepCopyInto(&out.ShootConfiguration)
@@ -245,6 +340,11 @@ func (in *LandscaperServiceConfiguration) DeepCopyInto(out *LandscaperServiceCon
 		*out = new(corev1alpha1.ObjectReference)
 		**out = **in
 	}
+	if in.Rebalancing != nil {
+		in, out := &in.Rebalancing, &out.Rebalancing
+		*out = new(RebalancingConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -298,6 +398,59 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushgatewaySinkConfiguration) DeepCopyInto(out *PushgatewaySinkConfiguration) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushgatewaySinkConfiguration.
+func (in *PushgatewaySinkConfiguration) DeepCopy() *PushgatewaySinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PushgatewaySinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancingConfiguration) DeepCopyInto(out *RebalancingConfiguration) {
+	*out = *in
+	out.Interval = in.Interval
+	out.MigrationInterval = in.MigrationInterval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancingConfiguration.
+func (in *RebalancingConfiguration) DeepCopy() *RebalancingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTargetConfigProbeConfiguration) DeepCopyInto(out *ServiceTargetConfigProbeConfiguration) {
+	*out = *in
+	out.PeriodicProbeInterval = in.PeriodicProbeInterval
+	out.ProbeTimeout = in.ProbeTimeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTargetConfigProbeConfiguration.
+func (in *ServiceTargetConfigProbeConfiguration) DeepCopy() *ServiceTargetConfigProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceTargetConfigProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootAutoUpdateConfig) DeepCopyInto(out *ShootAutoUpdateConfig) {
 	*out = *in
@@ -336,6 +489,11 @@ func (in *ShootConfiguration) DeepCopyInto(out *ShootConfiguration) {
 		*out = new(ControlPlane)
 		**out = **in
 	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ShootHibernation)
+		**out = **in
+	}
 	return
 }
 
@@ -349,6 +507,22 @@ func (in *ShootConfiguration) DeepCopy() *ShootConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHibernation) DeepCopyInto(out *ShootHibernation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHibernation.
+func (in *ShootHibernation) DeepCopy() *ShootHibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootKubernetesConfig) DeepCopyInto(out *ShootKubernetesConfig) {
 	*out = *in
@@ -503,6 +677,26 @@ func (in *ShootWorkersConfiguration) DeepCopy() *ShootWorkersConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportedVersion) DeepCopyInto(out *SupportedVersion) {
+	*out = *in
+	if in.DeprecatedSince != nil {
+		in, out := &in.DeprecatedSince, &out.DeprecatedSince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportedVersion.
+func (in *SupportedVersion) DeepCopy() *SupportedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetShootSidecarConfiguration) DeepCopyInto(out *TargetShootSidecarConfiguration) {
 	*out = *in
@@ -533,3 +727,45 @@ func (in *TargetShootSidecarConfiguration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UploadRetryConfiguration) DeepCopyInto(out *UploadRetryConfiguration) {
+	*out = *in
+	out.InitialBackoff = in.InitialBackoff
+	out.OpenDuration = in.OpenDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploadRetryConfiguration.
+func (in *UploadRetryConfiguration) DeepCopy() *UploadRetryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UploadRetryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSinkConfiguration) DeepCopyInto(out *WebhookSinkConfiguration) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSinkConfiguration.
+func (in *WebhookSinkConfiguration) DeepCopy() *WebhookSinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
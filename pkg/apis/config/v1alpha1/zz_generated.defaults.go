@@ -30,7 +30,13 @@ func SetObjectDefaults_LandscaperServiceConfiguration(in *LandscaperServiceConfi
 	SetDefaults_LandscaperServiceConfiguration(in)
 	SetDefaults_AvailabilityMonitoringConfiguration(&in.AvailabilityMonitoring)
 	SetDefaults_CrdManagementConfiguration(&in.CrdManagement)
+	SetDefaults_ServiceTargetConfigProbeConfiguration(&in.ServiceTargetConfigProbe)
+	SetDefaults_InstanceKubeconfigsConfiguration(&in.InstanceKubeconfigs)
+	SetDefaults_LandscaperServiceComponentConfiguration(&in.LandscaperServiceComponent)
 	SetDefaults_ShootConfiguration(&in.ShootConfiguration)
+	if in.Rebalancing != nil {
+		SetDefaults_RebalancingConfiguration(in.Rebalancing)
+	}
 }
 
 func SetObjectDefaults_TargetShootSidecarConfiguration(in *TargetShootSidecarConfiguration) {
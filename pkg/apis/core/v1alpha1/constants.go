@@ -18,6 +18,10 @@ const (
 	ShootInstanceNamespaceLabel = "shoot.landscaper-service.gardener.cloud/instanceNamespace"
 	ShootInstanceIDLabel        = "shoot.landscaper-service.gardener.cloud/instanceId"
 
+	// InstanceKubeconfigTypeLabel is set on the secrets containing the kubeconfigs of an instance.
+	// The value is either "user" or "admin".
+	InstanceKubeconfigTypeLabel = "landscaper-service.gardener.cloud/kubeconfig-type"
+
 	// LandscaperServiceOperationAnnotation is the operation annotation.
 	LandscaperServiceOperationAnnotation = "landscaper-service.gardener.cloud/operation"
 	// LandscaperServiceOperationIgnore can be set as the landscaper service operation annotation.
@@ -25,6 +29,10 @@ const (
 	// and prevents its reconciliation until removed.
 	LandscaperServiceOperationIgnore = "ignore"
 
+	// InstanceMigrationAnnotation requests the migration of an instance to a different ServiceTargetConfig.
+	// The value is the reference to the target ServiceTargetConfig in the format "<namespace>/<name>".
+	InstanceMigrationAnnotation = "landscaper-service.gardener.cloud/migrate-to"
+
 	LandscaperServiceOnDeleteStrategyAnnotation                             = "landscaper-service.gardener.cloud/on-delete-strategy"
 	LandscaperServiceOnDeleteStrategyDeleteAllInstallations                 = "delete-all-installations"
 	LandscaperServiceOnDeleteStrategyDeleteAllInstallationsWithoutUninstall = "delete-all-installations-without-uninstall"
@@ -50,6 +50,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SubjectListList{},
 		&TargetScheduling{},
 		&TargetSchedulingList{},
+		&RebalancePlan{},
+		&RebalancePlanList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -50,10 +50,14 @@ type AvailabilityCollectionStatus struct {
 	// +optional
 	LastRun metav1.Time `json:"lastRun"`
 
-	// LastReported is the last time, the AV Uploader uploaded all instance status. Prevents multi upload of the same status.
+	// LastReported is the last time, the AV Uploader uploaded all instance status to all sinks. Prevents multi upload of the same status.
 	// +optional
 	LastReported metav1.Time `json:"lastReported"`
 
+	// Sinks contains the last reported time of every availability sink, so that a failing sink does not block the others.
+	// +optional
+	Sinks []AvailabilitySinkStatus `json:"sinks,omitempty"`
+
 	// Instances collects the status for all instances specified in spec.instanceRefs
 	Instances []AvailabilityInstance `json:"instances"`
 
@@ -61,6 +65,73 @@ type AvailabilityCollectionStatus struct {
 	Self AvailabilityInstance `json:"self"`
 }
 
+// AvailabilitySinkStatus contains the reporting status of an availability sink.
+type AvailabilitySinkStatus struct {
+	// Name is the name of the sink.
+	Name string `json:"name"`
+	// LastReported is the time of the last health watcher run that has been reported to the sink.
+	LastReported metav1.Time `json:"lastReported"`
+	// Backlog contains the health watcher runs which could not be reported to the sink yet, the oldest first.
+	// They are reported in order once the sink is available again.
+	// +optional
+	Backlog []AvailabilitySnapshot `json:"backlog,omitempty"`
+}
+
+// AvailabilitySnapshot is the availability of all monitored landscapers of a health watcher run.
+type AvailabilitySnapshot struct {
+	// Timestamp is the time of the health watcher run.
+	Timestamp metav1.Time `json:"timestamp"`
+	// MonitoredInstances is the number of monitored landscapers, including the own landscaper.
+	MonitoredInstances int `json:"monitoredInstances"`
+	// FailedInstances are the landscapers which have been unavailable.
+	// +optional
+	FailedInstances []FailedAvailabilityInstance `json:"failedInstances,omitempty"`
+}
+
+// FailedAvailabilityInstance is an unavailable landscaper of an availability snapshot.
+type FailedAvailabilityInstance struct {
+	ObjectReference `json:",inline"`
+	// Self is set for the own landscaper.
+	// +optional
+	Self bool `json:"self,omitempty"`
+	// Identity identifies the instance for the support and the tenant.
+	// +optional
+	Identity *InstanceIdentity `json:"identity,omitempty"`
+	// FailedReason is the reason the landscaper has been unavailable.
+	FailedReason string `json:"failedReason"`
+	// FailureType tells whether the landscaper or the external data plane cluster has been unavailable.
+	// +optional
+	FailureType string `json:"failureType,omitempty"`
+}
+
+// LsHealthCheckStatusHibernated is the availability status of an instance which is hibernated.
+// Hibernated instances are not reported as outages.
+const LsHealthCheckStatusHibernated v1alpha1.LsHealthCheckStatus = "Hibernated"
+
+// LsHealthCheckStatusUnknown is the availability status of an instance which could not be loaded.
+// The availability of the instance could not be determined, therefore it is not reported as outage.
+const LsHealthCheckStatusUnknown v1alpha1.LsHealthCheckStatus = "Unknown"
+
+// The status of an AvailabilityCollection is limited in size, so that it stays well below the object size limit of etcd (1.5 MiB)
+// even with a large number of instances, outages and runs which could not be reported.
+// Otherwise every status update fails and the availability monitoring stops completely.
+const (
+	// AvailabilityHistoryMaxSize is the maximum serialized size in bytes of the instances and the own landscaper
+	// in the status, the oldest ended outages are dropped if it is exceeded.
+	AvailabilityHistoryMaxSize = 768 * 1024
+	// AvailabilityBacklogMaxSize is the maximum serialized size in bytes of the backlogs of all sinks in the status,
+	// the oldest runs are dropped if it is exceeded.
+	AvailabilityBacklogMaxSize = 256 * 1024
+)
+
+const (
+	// AvailabilityFailureTypeLandscaper is the failure type of an instance whose landscaper is unavailable.
+	AvailabilityFailureTypeLandscaper = "Landscaper"
+	// AvailabilityFailureTypeDataPlane is the failure type of an instance whose external data plane cluster is unavailable.
+	// The landscaper of such an instance can't work, although it may be healthy itself.
+	AvailabilityFailureTypeDataPlane = "DataPlane"
+)
+
 // AvailabilityInstance contains the availability status for one instance.
 type AvailabilityInstance struct {
 	ObjectReference `json:",inline"`
@@ -72,6 +143,69 @@ type AvailabilityInstance struct {
 	// FailedSince contains the timestamp since the object is in failed status
 	// +optional
 	FailedSince *metav1.Time `json:"failedSince,omitempty"`
+
+	// FailureType tells whether the landscaper or the external data plane cluster is unavailable.
+	// It is only set if the status is failed.
+	// +optional
+	FailureType string `json:"failureType,omitempty"`
+
+	// DataPlaneFailedSince contains the timestamp since the probe of the external data plane cluster fails.
+	// +optional
+	DataPlaneFailedSince *metav1.Time `json:"dataPlaneFailedSince,omitempty"`
+
+	// Identity identifies the instance for the support and the tenant.
+	// +optional
+	Identity *InstanceIdentity `json:"identity,omitempty"`
+
+	// MonitoredSince is the time since which the availability of the instance is recorded.
+	// +optional
+	MonitoredSince *metav1.Time `json:"monitoredSince,omitempty"`
+
+	// Outages are the outages of the instance within the availability history period, the most recent last.
+	// +optional
+	Outages []Outage `json:"outages,omitempty"`
+
+	// Availability contains the availability of the instance within the last day, week and month.
+	// +optional
+	Availability *Availability `json:"availability,omitempty"`
+}
+
+// InstanceIdentity identifies a monitored instance independent of the generated name of the Instance object.
+type InstanceIdentity struct {
+	// TenantId is the unique identifier of the owning tenant.
+	TenantId string `json:"tenantId"`
+	// InstanceId is the id of the instance.
+	InstanceId string `json:"instanceId"`
+	// LandscaperDeployment is the name of the owning LandscaperDeployment.
+	// +optional
+	LandscaperDeployment string `json:"landscaperDeployment,omitempty"`
+	// Purpose is the purpose of the owning LandscaperDeployment.
+	// +optional
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// Outage is a time interval in which an instance has been reported as failed.
+type Outage struct {
+	// Start is the time at which the instance has been reported as failed.
+	Start metav1.Time `json:"start"`
+	// End is the time at which the instance has been reported as available again.
+	// It is not set for an ongoing outage.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+	// Reason is the reason of the failed status at the start of the outage.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// Availability contains the availability percentages of an instance.
+// The percentages are computed for the time the instance has been monitored, if it is shorter than the period.
+type Availability struct {
+	// Day is the availability in percent within the last 24 hours.
+	Day string `json:"day"`
+	// Week is the availability in percent within the last 7 days.
+	Week string `json:"week"`
+	// Month is the availability in percent within the last 30 days.
+	Month string `json:"month"`
 }
 
 func (r *AvailabilityInstance) SetStatusAndFailedSince(status v1alpha1.LsHealthCheckStatus, failedReason string, initOrContinueFailed bool) {
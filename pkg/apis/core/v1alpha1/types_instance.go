@@ -28,7 +28,10 @@ type InstanceList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="ServiceTargetConfig",type=string,JSONPath=`.spec.serviceTargetConfigRef.name`
 // +kubebuilder:printcolumn:name="Installation",type=string,JSONPath=`.status.installationRef.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.landscaperServiceComponent.version`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Migration",type=string,priority=1,JSONPath=`.status.migration.phase`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type Instance struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -74,6 +77,22 @@ type InstanceSpec struct {
 	// create its own Kubernetes cluster.
 	// +optional
 	DataPlane *DataPlane `json:"dataPlane,omitempty"`
+
+	// LandscaperVersion is the version of the landscaper instance component that is installed for this instance.
+	// If the minor version is no longer supported, the next supported minor version is installed.
+	// If not set, the default version of the landscaper service is installed.
+	// +optional
+	LandscaperVersion string `json:"landscaperVersion,omitempty"`
+
+	// MaintenanceTimeWindow is the daily time window during which changes of the landscaper version
+	// and the landscaper configuration are applied.
+	// If not set, the maintenance time window of the landscaper service configuration is used.
+	// +optional
+	MaintenanceTimeWindow *MaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty"`
+
+	// Hibernation specifies when the landscaper instance and its resource shoot cluster are hibernated.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
 }
 
 // AutomaticReconcile defines the automatic reconcile configuration.
@@ -99,6 +118,14 @@ type InstanceStatus struct {
 	// +optional
 	LandscaperServiceComponent *LandscaperServiceComponent `json:"landscaperServiceComponent,omitempty"`
 
+	// Maintenance contains the changes of the installation which are held until the next maintenance time window.
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+
+	// Hibernation contains the hibernation state of the landscaper instance.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
 	// ContextRef references the landscaper context for this Instance.
 	// +optional
 	ContextRef *ObjectReference `json:"contextRef,omitempty"`
@@ -120,13 +147,33 @@ type InstanceStatus struct {
 	ClusterEndpoint string `json:"clusterEndpoint,omitempty"`
 
 	// UserKubeconfig contains the user kubeconfig which can be used for accessing the landscaper cluster.
+	// Deprecated: The user kubeconfig is only set when enabled in the landscaper service configuration.
+	// Use the secret referenced by UserKubeconfigSecretRef instead.
 	// +optional
 	UserKubeconfig string `json:"userKubeconfig,omitempty"`
 
 	// AdminKubeconfig contains the admin kubeconfig which can be used for accessing the landscaper cluster.
+	// Deprecated: The admin kubeconfig is only set when enabled in the landscaper service configuration.
+	// Use the secret referenced by AdminKubeconfigSecretRef instead.
 	// +optional
 	AdminKubeconfig string `json:"adminKubeconfig,omitempty"`
 
+	// UserKubeconfigSecretRef references the secret containing the user kubeconfig.
+	// +optional
+	UserKubeconfigSecretRef *SecretReference `json:"userKubeconfigSecretRef,omitempty"`
+
+	// UserKubeconfigExpirationTime is the time at which the user kubeconfig expires.
+	// +optional
+	UserKubeconfigExpirationTime *metav1.Time `json:"userKubeconfigExpirationTime,omitempty"`
+
+	// AdminKubeconfigSecretRef references the secret containing the admin kubeconfig.
+	// +optional
+	AdminKubeconfigSecretRef *SecretReference `json:"adminKubeconfigSecretRef,omitempty"`
+
+	// AdminKubeconfigExpirationTime is the time at which the admin kubeconfig expires.
+	// +optional
+	AdminKubeconfigExpirationTime *metav1.Time `json:"adminKubeconfigExpirationTime,omitempty"`
+
 	// ShootName is the name of the corresponding shoot cluster.
 	// +optional
 	ShootName string `json:"shootName,omitempty"`
@@ -135,6 +182,15 @@ type InstanceStatus struct {
 	// +optional
 	ShootNamespace string `json:"shootNamespace,omitempty"`
 
+	// ShootRegion is the region of the shoot cluster.
+	// It is recorded when the shoot cluster is created, as the region of a shoot cluster can't be changed.
+	// +optional
+	ShootRegion string `json:"shootRegion,omitempty"`
+
+	// ShootZone is the zone of the shoot cluster, which is recorded together with the region.
+	// +optional
+	ShootZone string `json:"shootZone,omitempty"`
+
 	// Reference to the external data plane cluster target.
 	// +optional
 	ExternalDataPlaneClusterRef *ObjectReference `json:"externalDataPlaneClusterRef,omitempty"`
@@ -142,6 +198,82 @@ type InstanceStatus struct {
 	// Phase represents the phase of the corresponding Landscaper Instance Installation phase.
 	// +optional
 	Phase string `json:"phase,omitempty"`
+
+	// Conditions describe the state of the target, context, installation and kubeconfig exports of this Instance.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Migration contains the status of the last migration of this Instance to a different ServiceTargetConfig.
+	// +optional
+	Migration *InstanceMigrationStatus `json:"migration,omitempty"`
+}
+
+// InstanceMigrationPhase is the phase of an instance migration.
+type InstanceMigrationPhase string
+
+const (
+	// InstanceMigrationPhasePending is the phase of a requested migration whose preconditions are not yet fulfilled.
+	InstanceMigrationPhasePending InstanceMigrationPhase = "Pending"
+	// InstanceMigrationPhaseDeletingInstallation is the phase in which the installation is deleted without uninstalling the landscaper.
+	InstanceMigrationPhaseDeletingInstallation InstanceMigrationPhase = "DeletingInstallation"
+	// InstanceMigrationPhaseCleaningUpSourceCluster is the phase in which the landscaper is removed from the source target cluster.
+	InstanceMigrationPhaseCleaningUpSourceCluster InstanceMigrationPhase = "CleaningUpSourceCluster"
+	// InstanceMigrationPhaseDeletingTarget is the phase in which the target and the context of the source target cluster are deleted.
+	InstanceMigrationPhaseDeletingTarget InstanceMigrationPhase = "DeletingTarget"
+	// InstanceMigrationPhaseSwitchingServiceTargetConfig is the phase in which the instance is moved to the target ServiceTargetConfig.
+	InstanceMigrationPhaseSwitchingServiceTargetConfig InstanceMigrationPhase = "SwitchingServiceTargetConfig"
+	// InstanceMigrationPhaseInstalling is the phase in which the landscaper is installed on the target cluster of the new ServiceTargetConfig.
+	InstanceMigrationPhaseInstalling InstanceMigrationPhase = "Installing"
+	// InstanceMigrationPhaseSucceeded is the phase of a finished migration.
+	InstanceMigrationPhaseSucceeded InstanceMigrationPhase = "Succeeded"
+)
+
+// InstanceMigrationStatus contains the status of an instance migration.
+type InstanceMigrationStatus struct {
+	// Phase is the current phase of the migration.
+	Phase InstanceMigrationPhase `json:"phase"`
+
+	// SourceServiceTargetConfigRef references the ServiceTargetConfig from which the instance is migrated.
+	SourceServiceTargetConfigRef ObjectReference `json:"sourceServiceTargetConfigRef"`
+
+	// TargetServiceTargetConfigRef references the ServiceTargetConfig to which the instance is migrated.
+	TargetServiceTargetConfigRef ObjectReference `json:"targetServiceTargetConfigRef"`
+
+	// StartTime is the time when the migration has been started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// LastTransitionTime is the last time the migration phase has changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// Message contains details about the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// IsStarted returns true if the migration has left the pending phase and is not yet finished.
+// A started migration can't be cancelled anymore.
+func (s *InstanceMigrationStatus) IsStarted() bool {
+	return s.Phase != InstanceMigrationPhasePending && s.Phase != InstanceMigrationPhaseSucceeded
+}
+
+// GetMigrationTarget returns the ServiceTargetConfig reference of the migration annotation.
+// Returns nil if the instance has no migration annotation.
+func (ld *Instance) GetMigrationTarget() (*ObjectReference, error) {
+	value, ok := ld.GetAnnotations()[InstanceMigrationAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	return ParseObjectReference(value)
+}
+
+// IsMigrating returns true if the migration of the instance has been requested and is not yet finished.
+func (ld *Instance) IsMigrating() bool {
+	if _, ok := ld.GetAnnotations()[InstanceMigrationAnnotation]; ok {
+		return true
+	}
+	return ld.Status.Migration != nil && ld.Status.Migration.IsStarted()
 }
 
 func (ld *Instance) IsExternalDataPlane() bool {
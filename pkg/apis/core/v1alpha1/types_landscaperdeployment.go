@@ -30,7 +30,9 @@ type LandscaperDeploymentList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="DataPlaneType",type=string,JSONPath=`.status.dataPlaneType`
 // +kubebuilder:printcolumn:name="Instance",type=string,JSONPath=`.status.instanceRef.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.landscaperServiceComponent.version`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type LandscaperDeployment struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -69,6 +71,73 @@ type LandscaperDeploymentSpec struct {
 	// create its own Kubernetes cluster.
 	// +optional
 	DataPlane *DataPlane `json:"dataPlane,omitempty"`
+
+	// LandscaperVersion is the version of the landscaper instance component that is installed for this deployment.
+	// If not set, the default version of the landscaper service is installed.
+	// +optional
+	LandscaperVersion string `json:"landscaperVersion,omitempty"`
+
+	// MaintenanceTimeWindow is the daily time window during which changes of the landscaper version
+	// and the landscaper configuration are applied.
+	// If not set, the maintenance time window of the landscaper service configuration is used.
+	// +optional
+	MaintenanceTimeWindow *MaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty"`
+
+	// Hibernation specifies when the landscaper instance and its resource shoot cluster are hibernated.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
+
+	// TopologyAffinity restricts or prefers the ServiceTargetConfigs on which the landscaper instance is scheduled
+	// by their topology, e.g. to keep the data of a tenant in a certain region.
+	// The affinity is only applied when the LandscaperDeployment is scheduled, changes don't move an existing instance.
+	// +optional
+	TopologyAffinity *TopologyAffinity `json:"topologyAffinity,omitempty"`
+}
+
+// TopologyKey is the attribute of the topology of a ServiceTargetConfig which is matched by a topology requirement.
+type TopologyKey string
+
+const (
+	// TopologyKeyRegion matches the region of a ServiceTargetConfig.
+	TopologyKeyRegion TopologyKey = "region"
+	// TopologyKeyProvider matches the provider of a ServiceTargetConfig.
+	TopologyKeyProvider TopologyKey = "provider"
+	// TopologyKeyZone matches the zone of a ServiceTargetConfig.
+	TopologyKeyZone TopologyKey = "zone"
+)
+
+// TopologyAffinity specifies the topology of the ServiceTargetConfigs on which a LandscaperDeployment is scheduled.
+type TopologyAffinity struct {
+	// Required contains requirements which must all be fulfilled by a ServiceTargetConfig.
+	// ServiceTargetConfigs which don't fulfill them are never selected.
+	// +optional
+	Required []TopologyRequirement `json:"required,omitempty"`
+
+	// Preferred contains weighted requirements. The weights of the fulfilled requirements are summed up
+	// and increase the score of a ServiceTargetConfig by this percentage.
+	// +optional
+	Preferred []WeightedTopologyRequirement `json:"preferred,omitempty"`
+}
+
+// TopologyRequirement matches an attribute of the topology of a ServiceTargetConfig.
+type TopologyRequirement struct {
+	// Key is the matched attribute, one of region, provider or zone.
+	Key TopologyKey `json:"key"`
+
+	// Operator is one of In, NotIn or Prefix, which is applied to the values.
+	// NotIn also matches ServiceTargetConfigs which don't specify the attribute.
+	Operator SelectorOperator `json:"operator"`
+
+	// Values are compared with the attribute according to the operator.
+	Values []string `json:"values"`
+}
+
+// WeightedTopologyRequirement is a topology requirement with a weight.
+type WeightedTopologyRequirement struct {
+	// Weight is a number between 1 and 100.
+	Weight int64 `json:"weight"`
+
+	TopologyRequirement `json:",inline"`
 }
 
 // LandscaperDeploymentStatus contains the status of a LandscaperDeployment.
@@ -93,6 +162,28 @@ type LandscaperDeploymentStatus struct {
 	// DataPlaneType shows whether this deployment has an internal or external data plane cluster.
 	// +optional
 	DataPlaneType string `json:"dataPlaneType,omitempty"`
+
+	// LandscaperServiceComponent mirrors the landscaper service component that is used by the corresponding Instance.
+	// +optional
+	LandscaperServiceComponent *LandscaperServiceComponent `json:"landscaperServiceComponent,omitempty"`
+
+	// Maintenance mirrors the maintenance status of the corresponding Instance.
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+
+	// Hibernation mirrors the hibernation status of the corresponding Instance.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
+	// Scheduling explains how the ServiceTargetConfig of the corresponding Instance has been selected.
+	// +optional
+	Scheduling *SchedulingExplanation `json:"scheduling,omitempty"`
+
+	// Conditions mirror the conditions of the corresponding Instance.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 func (ld *LandscaperDeployment) IsExternalDataPlane() bool {
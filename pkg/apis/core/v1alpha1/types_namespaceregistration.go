@@ -23,6 +23,7 @@ type NamespaceRegistrationList struct {
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 type NamespaceRegistration struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -39,6 +40,11 @@ type NamespaceRegistrationStatus struct {
 	Phase string `json:"phase"`
 	// +optional
 	LastError *Error `json:"lastError,omitempty"`
+	// Conditions describe whether the registered namespace is ready to be used.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 type NamespaceRegistrationSpec struct {
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RebalancePlanList contains a list of RebalancePlan
+type RebalancePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RebalancePlan `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RebalancePlan is created/updated by the rebalancing controller.
+// It contains the proposed moves of Instances between ServiceTargetConfigs,
+// which are carried out after the plan has been approved by an operator.
+// +kubebuilder:resource:singular="rebalanceplan",path="rebalanceplans",shortName="rbplan",scope="Namespaced"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Approved",type=boolean,JSONPath=`.spec.approved`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Last Proposal",type=date,JSONPath=`.status.lastProposalTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type RebalancePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification for the RebalancePlan.
+	Spec RebalancePlanSpec `json:"spec"`
+
+	// Status contains the status for the RebalancePlan.
+	// +optional
+	Status RebalancePlanStatus `json:"status"`
+}
+
+// RebalancePlanSpec contains the specification for a RebalancePlan.
+type RebalancePlanSpec struct {
+	// Approved has to be set to true by an operator to carry out the proposed moves.
+	// It is reset to false by the rebalancing controller whenever it proposes different moves.
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+
+	// Moves are the proposed moves of Instances to other ServiceTargetConfigs.
+	// +optional
+	Moves []RebalanceMove `json:"moves,omitempty"`
+}
+
+// RebalanceMove is the proposed move of an Instance to another ServiceTargetConfig.
+type RebalanceMove struct {
+	// InstanceRef references the Instance which is moved.
+	InstanceRef ObjectReference `json:"instanceRef"`
+
+	// SourceServiceTargetConfigRef references the ServiceTargetConfig on which the Instance is currently scheduled.
+	SourceServiceTargetConfigRef ObjectReference `json:"sourceServiceTargetConfigRef"`
+
+	// TargetServiceTargetConfigRef references the ServiceTargetConfig to which the Instance is moved.
+	TargetServiceTargetConfigRef ObjectReference `json:"targetServiceTargetConfigRef"`
+
+	// Reason describes why the Instance is moved.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// RebalancePlanPhase is the phase of a RebalancePlan.
+type RebalancePlanPhase string
+
+const (
+	// RebalancePlanPhaseBalanced is the phase of a plan without moves, i.e. the Instances are distributed as the scheduling would do.
+	RebalancePlanPhaseBalanced RebalancePlanPhase = "Balanced"
+	// RebalancePlanPhaseProposed is the phase of a plan whose moves wait for the approval.
+	RebalancePlanPhaseProposed RebalancePlanPhase = "Proposed"
+	// RebalancePlanPhaseProgressing is the phase of an approved plan whose moves are carried out.
+	RebalancePlanPhaseProgressing RebalancePlanPhase = "Progressing"
+	// RebalancePlanPhaseCompleted is the phase of an approved plan whose moves are all finished.
+	RebalancePlanPhaseCompleted RebalancePlanPhase = "Completed"
+)
+
+// RebalanceMovePhase is the phase of a single move of an approved RebalancePlan.
+type RebalanceMovePhase string
+
+const (
+	// RebalanceMovePhasePending is the phase of a move which has not yet been started.
+	RebalanceMovePhasePending RebalanceMovePhase = "Pending"
+	// RebalanceMovePhaseMigrating is the phase of a move whose instance migration has been requested.
+	RebalanceMovePhaseMigrating RebalanceMovePhase = "Migrating"
+	// RebalanceMovePhaseSucceeded is the phase of a move whose Instance has been migrated to the target ServiceTargetConfig.
+	RebalanceMovePhaseSucceeded RebalanceMovePhase = "Succeeded"
+	// RebalanceMovePhaseSkipped is the phase of a move which is no longer applicable, e.g. because the Instance has been deleted.
+	RebalanceMovePhaseSkipped RebalanceMovePhase = "Skipped"
+	// RebalanceMovePhaseFailed is the phase of a move whose migration has not been finished on the target ServiceTargetConfig.
+	RebalanceMovePhaseFailed RebalanceMovePhase = "Failed"
+)
+
+// IsFinished returns true if the move will not be processed any further.
+func (p RebalanceMovePhase) IsFinished() bool {
+	return p == RebalanceMovePhaseSucceeded || p == RebalanceMovePhaseSkipped || p == RebalanceMovePhaseFailed
+}
+
+// RebalancePlanStatus contains the status for a RebalancePlan.
+type RebalancePlanStatus struct {
+	// ObservedGeneration is the generation of the approved plan whose moves are reported in the status.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the current phase of the plan.
+	// +optional
+	Phase RebalancePlanPhase `json:"phase,omitempty"`
+
+	// LastProposalTime is the last time the distribution of the Instances has been compared with the scheduling.
+	// +optional
+	LastProposalTime *metav1.Time `json:"lastProposalTime,omitempty"`
+
+	// LastMigrationTime is the last time the migration of an Instance has been requested.
+	// +optional
+	LastMigrationTime *metav1.Time `json:"lastMigrationTime,omitempty"`
+
+	// Moves contains the progress of the moves of the approved plan.
+	// +optional
+	Moves []RebalanceMoveStatus `json:"moves,omitempty"`
+}
+
+// RebalanceMoveStatus contains the progress of a single move.
+type RebalanceMoveStatus struct {
+	// InstanceRef references the Instance which is moved.
+	InstanceRef ObjectReference `json:"instanceRef"`
+
+	// Phase is the current phase of the move.
+	Phase RebalanceMovePhase `json:"phase"`
+
+	// StartTime is the time when the migration of the Instance has been requested.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message describes why the move is waiting, skipped or failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -25,6 +25,10 @@ type ServiceTargetConfigList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Visible",type=string,JSONPath=`.metadata.labels.config\.landscaper-service\.gardener\.cloud/visible`
 // +kubebuilder:printcolumn:name="Priority",type=number,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.topology.region`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Cordoned",type=string,JSONPath=`.spec.cordon.reason`
+// +kubebuilder:printcolumn:name="Drain",type=string,priority=1,JSONPath=`.status.drain.phase`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type ServiceTargetConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -54,6 +58,60 @@ type ServiceTargetConfigSpec struct {
 
 	// IngressDomain is the ingress domain of the corresponding target cluster.
 	IngressDomain string `json:"ingressDomain"`
+
+	// MaxInstances is the maximum number of instances that can be scheduled on the target cluster.
+	// +optional
+	MaxInstances *int64 `json:"maxInstances,omitempty"`
+
+	// ResourceBudget is the maximum amount of cpu and memory that can be requested by all instances
+	// scheduled on the target cluster. The requests of an instance are taken from its landscaper configuration resources.
+	// +optional
+	ResourceBudget *ResourceRequests `json:"resourceBudget,omitempty"`
+
+	// Topology describes the location of the target cluster.
+	// It is matched against the topology affinity of LandscaperDeployments during scheduling.
+	// +optional
+	Topology *Topology `json:"topology,omitempty"`
+
+	// Cordon marks the ServiceTargetConfig as unschedulable, e.g. before its target cluster is replaced or upgraded.
+	// No new instances are scheduled on a cordoned ServiceTargetConfig.
+	// The existing instances are only migrated to other ServiceTargetConfigs if a drain is requested.
+	// +optional
+	Cordon *Cordon `json:"cordon,omitempty"`
+}
+
+// Cordon marks a ServiceTargetConfig as unschedulable.
+type Cordon struct {
+	// Reason describes why the ServiceTargetConfig is cordoned.
+	Reason string `json:"reason"`
+
+	// Drain requests the migration of all instances of the ServiceTargetConfig to other ServiceTargetConfigs.
+	// +optional
+	Drain *Drain `json:"drain,omitempty"`
+}
+
+// Drain configures the migration of all instances of a cordoned ServiceTargetConfig.
+type Drain struct {
+	// MaxConcurrentMigrations is the maximum number of instances which are migrated at the same time, defaults to 1.
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty"`
+}
+
+// Topology describes the location of a target cluster.
+type Topology struct {
+	// Region is the region of the target cluster, e.g. "eu-west-1".
+	// If the provider is not set or equal to the provider type of the shoot configuration,
+	// the resource shoot clusters of the instances on this target cluster are created in this region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Provider is the infrastructure provider of the target cluster, e.g. "aws".
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Zone is the zone of the target cluster within its region, e.g. "eu-west-1a".
+	// +optional
+	Zone string `json:"zone,omitempty"`
 }
 
 // ServiceTargetConfigStatus contains the status of a ServiceTargetConfig.
@@ -66,4 +124,77 @@ type ServiceTargetConfigStatus struct {
 	// InstanceRefs is the list of references to instances that use this ServiceTargetConfig.
 	// +optional
 	InstanceRefs []ObjectReference `json:"instanceRefs,omitempty"`
+
+	// Conditions contains the results of the last health probe of the target cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastProbeTime is the last time the target cluster has been probed.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Drain contains the progress of the drain of the ServiceTargetConfig.
+	// +optional
+	Drain *DrainStatus `json:"drain,omitempty"`
+}
+
+// DrainPhase is the phase of the drain of a ServiceTargetConfig.
+type DrainPhase string
+
+const (
+	// DrainPhaseDraining is the phase while instances are migrated to other ServiceTargetConfigs.
+	DrainPhaseDraining DrainPhase = "Draining"
+	// DrainPhaseDrained is the phase when no instance is left on the ServiceTargetConfig.
+	DrainPhaseDrained DrainPhase = "Drained"
+)
+
+// DrainStatus contains the progress of the drain of a ServiceTargetConfig.
+type DrainStatus struct {
+	// Phase is the current phase of the drain.
+	Phase DrainPhase `json:"phase"`
+
+	// StartTime is the time when the drain has been started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// RemainingInstances is the number of instances which are still on the ServiceTargetConfig,
+	// including the instances which are currently migrated.
+	RemainingInstances int `json:"remainingInstances"`
+
+	// MigratingInstances references the instances which are currently migrated to other ServiceTargetConfigs.
+	// +optional
+	MigratingInstances []ObjectReference `json:"migratingInstances,omitempty"`
+
+	// MigratedInstances is the number of instances which have been migrated since the drain has been started.
+	MigratedInstances int `json:"migratedInstances"`
+
+	// Message describes why instances can't be migrated.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	// ServiceTargetConfigConditionReachable indicates whether the API server of the target cluster is reachable.
+	ServiceTargetConfigConditionReachable = "Reachable"
+	// ServiceTargetConfigConditionLandscaperCRDsInstalled indicates whether the Landscaper CRDs are installed on the target cluster.
+	ServiceTargetConfigConditionLandscaperCRDsInstalled = "LandscaperCRDsInstalled"
+	// ServiceTargetConfigConditionReady indicates whether the target cluster can host Landscaper instances.
+	ServiceTargetConfigConditionReady = "Ready"
+)
+
+// IsCordoned returns true if no new instances may be scheduled on the ServiceTargetConfig.
+func (c *ServiceTargetConfig) IsCordoned() bool {
+	return c.Spec.Cordon != nil
+}
+
+// IsNotReady returns true if the last health probe reported the target cluster as not ready.
+// A ServiceTargetConfig that has not been probed yet is not considered as not ready.
+func (c *ServiceTargetConfig) IsNotReady() bool {
+	for _, condition := range c.Status.Conditions {
+		if condition.Type == ServiceTargetConfigConditionReady {
+			return condition.Status != metav1.ConditionTrue
+		}
+	}
+	return false
 }
@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -42,6 +45,15 @@ func (r *ObjectReference) IsObject(o metav1.Object) bool {
 	return r.Name == o.GetName() && r.Namespace == o.GetNamespace()
 }
 
+// ParseObjectReference parses an object reference in the format "<namespace>/<name>".
+func ParseObjectReference(value string) (*ObjectReference, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, fmt.Errorf("invalid object reference %q, expected format <namespace>/<name>", value)
+	}
+	return &ObjectReference{Name: parts[1], Namespace: parts[0]}, nil
+}
+
 // SecretReference is a reference to data in a secret.
 type SecretReference struct {
 	ObjectReference `json:",inline"`
@@ -69,6 +81,72 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+const (
+	// ConditionTypeTargetReady indicates whether the targets of an instance have been created.
+	ConditionTypeTargetReady = "TargetReady"
+	// ConditionTypeContextReady indicates whether the landscaper context of an instance has been created.
+	ConditionTypeContextReady = "ContextReady"
+	// ConditionTypeInstallationReady indicates whether the installation of an instance has succeeded.
+	ConditionTypeInstallationReady = "InstallationReady"
+	// ConditionTypeKubeconfigExported indicates whether the kubeconfigs of an instance have been exported.
+	ConditionTypeKubeconfigExported = "KubeconfigExported"
+	// ConditionTypeReady indicates whether the resource is ready.
+	// It is true when all other conditions of the resource are true.
+	ConditionTypeReady = "Ready"
+)
+
+// MaintenanceTimeWindowLayout is the layout of the begin and end of a maintenance time window, e.g. "220000+0100".
+const MaintenanceTimeWindowLayout = "150405-0700"
+
+// MaintenanceTimeWindow specifies a daily time window during which changes of the landscaper instance are applied.
+type MaintenanceTimeWindow struct {
+	// Begin is the beginning of the time window in the format "HHMMSS+ZZZZ", e.g. "220000+0100".
+	Begin string `json:"begin"`
+	// End is the ending of the time window in the format "HHMMSS+ZZZZ", e.g. "230000+0100".
+	End string `json:"end"`
+}
+
+// MaintenanceStatus contains information about changes which are held until the next maintenance time window.
+type MaintenanceStatus struct {
+	// PendingChanges describes the changes which are applied in the next maintenance time window.
+	// +optional
+	PendingChanges []string `json:"pendingChanges,omitempty"`
+	// NextMaintenanceTime is the beginning of the next maintenance time window.
+	// +optional
+	NextMaintenanceTime *metav1.Time `json:"nextMaintenanceTime,omitempty"`
+}
+
+// Hibernation specifies when a landscaper instance and its resource shoot cluster are hibernated.
+type Hibernation struct {
+	// Enabled hibernates the landscaper instance when set to true and wakes it up when set to false.
+	// If set, the schedules are ignored.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Schedules specify the periods during which the landscaper instance is hibernated.
+	// +optional
+	Schedules []HibernationSchedule `json:"schedules,omitempty"`
+}
+
+// HibernationSchedule specifies a period during which the landscaper instance is hibernated.
+type HibernationSchedule struct {
+	// Start is a cron expression specifying when the landscaper instance is hibernated, e.g. "00 20 * * 1,2,3,4,5".
+	Start string `json:"start"`
+	// End is a cron expression specifying when the landscaper instance is woken up, e.g. "00 08 * * 1,2,3,4,5".
+	End string `json:"end"`
+	// Location is the time zone of the cron expressions, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
+
+// HibernationStatus contains the hibernation state of a landscaper instance.
+type HibernationStatus struct {
+	// Hibernated indicates whether the landscaper instance is hibernated.
+	Hibernated bool `json:"hibernated"`
+	// NextTransitionTime is the time at which the next hibernation schedule starts or ends.
+	// +optional
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+}
+
 // LandscaperConfiguration contains the configuration for a landscaper service deployment.
 type LandscaperConfiguration struct {
 	// +optional
@@ -162,6 +240,15 @@ type LandscaperServiceComponent struct {
 
 	// Version defines the version of the landscaper service component.
 	Version string `json:"version"`
+
+	// Deprecated is true when the version is deprecated, because a newer minor or major version is supported.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// RemovalTime is the time after which a deprecated version may be removed from the supported versions.
+	// Instances still using the version afterwards are upgraded to the next supported minor version.
+	// +optional
+	RemovalTime *metav1.Time `json:"removalTime,omitempty"`
 }
 
 // OIDCConfig defines the OIDC configuration
@@ -41,6 +41,45 @@ type SchedulingRule struct {
 	ServiceTargetConfigs []ObjectReference `json:"serviceTargetConfigs,omitempty"`
 
 	Selector []Selector `json:"selector,omitempty"`
+
+	// Spread specifies how the instances of a tenant, or of LandscaperDeployments with the same label value,
+	// are spread over the ServiceTargetConfigs of this rule.
+	// If several matching rules with the highest priority specify a spread, the first one is applied.
+	// +optional
+	Spread *SpreadPolicy `json:"spread,omitempty"`
+}
+
+// SpreadMode defines whether a spread policy is a preference or a requirement.
+type SpreadMode string
+
+const (
+	// SpreadModeSoft prefers ServiceTargetConfigs which host fewer instances with the same tenant id or label value.
+	SpreadModeSoft SpreadMode = "Soft"
+	// SpreadModeHard excludes ServiceTargetConfigs on which the skew of the instances with the same tenant id or label value
+	// would exceed the maximum skew.
+	SpreadModeHard SpreadMode = "Hard"
+)
+
+// SpreadPolicy specifies how instances are spread over ServiceTargetConfigs,
+// so that the outage of one target cluster doesn't affect all instances of a tenant.
+type SpreadPolicy struct {
+	// Mode is either Soft or Hard, defaults to Soft.
+	// With Soft, the score of a ServiceTargetConfig is divided by the number of instances with the same tenant id or label value + 1.
+	// With Hard, a ServiceTargetConfig is excluded, if the number of instances with the same tenant id or label value
+	// would exceed the minimum number of all candidates by more than the maximum skew.
+	// +optional
+	Mode SpreadMode `json:"mode,omitempty"`
+
+	// LabelName is the name of a LandscaperDeployment label.
+	// If set, the instances of LandscaperDeployments with the same label value are spread,
+	// otherwise the instances with the same tenant id.
+	// +optional
+	LabelName string `json:"labelName,omitempty"`
+
+	// MaxSkew is the maximum difference of the number of instances with the same tenant id or label value
+	// between the ServiceTargetConfigs. Only used by the Hard mode, defaults to 1.
+	// +optional
+	MaxSkew *int64 `json:"maxSkew,omitempty"`
 }
 
 type Selector struct {
@@ -51,6 +90,15 @@ type Selector struct {
 	// +optional
 	MatchLabel *LabelSelector `json:"matchLabel,omitempty"`
 
+	// +optional
+	MatchPurpose *PurposeSelector `json:"matchPurpose,omitempty"`
+
+	// MatchExpression is a CEL expression over the LandscaperDeployment, which is available as variable "object".
+	// The expression must evaluate to a bool and may only access fields of a LandscaperDeployment.
+	// If a field or map key does not exist in the LandscaperDeployment, the selector doesn't match.
+	// +optional
+	MatchExpression string `json:"matchExpression,omitempty"`
+
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	// +optional
@@ -67,11 +115,143 @@ type Selector struct {
 	Not *Selector `json:"not,omitempty"`
 }
 
+// SelectorOperator defines how a selector compares a value of a LandscaperDeployment.
+type SelectorOperator string
+
+const (
+	// SelectorOpIn matches if the value is one of the values of the selector.
+	SelectorOpIn SelectorOperator = "In"
+	// SelectorOpNotIn matches if the value is none of the values of the selector, or if the label does not exist.
+	SelectorOpNotIn SelectorOperator = "NotIn"
+	// SelectorOpExists matches if the label exists. Only supported by label selectors.
+	SelectorOpExists SelectorOperator = "Exists"
+	// SelectorOpDoesNotExist matches if the label does not exist. Only supported by label selectors.
+	SelectorOpDoesNotExist SelectorOperator = "DoesNotExist"
+	// SelectorOpPrefix matches if the value starts with one of the values of the selector.
+	SelectorOpPrefix SelectorOperator = "Prefix"
+	// SelectorOpRegex matches if the complete value matches one of the regular expressions of the selector.
+	SelectorOpRegex SelectorOperator = "Regex"
+)
+
+// TenantSelector matches the tenant id of a LandscaperDeployment.
+// Without operator, the tenant id must be equal to the id of the selector.
 type TenantSelector struct {
 	ID string `json:"id,omitempty"`
+
+	// Operator is one of In, NotIn, Prefix or Regex, which is applied to the values of the selector.
+	// +optional
+	Operator SelectorOperator `json:"operator,omitempty"`
+
+	// +optional
+	Values []string `json:"values,omitempty"`
 }
 
+// LabelSelector matches a label of a LandscaperDeployment.
+// Without operator, the label must exist and its value must be equal to the value of the selector.
 type LabelSelector struct {
 	Name  string `json:"name,omitempty"`
 	Value string `json:"value,omitempty"`
+
+	// Operator is one of In, NotIn, Exists, DoesNotExist, Prefix or Regex.
+	// Except for Exists and DoesNotExist, the operator is applied to the values of the selector.
+	// +optional
+	Operator SelectorOperator `json:"operator,omitempty"`
+
+	// +optional
+	Values []string `json:"values,omitempty"`
 }
+
+// PurposeSelector matches the purpose of a LandscaperDeployment.
+// Without operator, the purpose must be equal to the value of the selector.
+type PurposeSelector struct {
+	Value string `json:"value,omitempty"`
+
+	// Operator is one of In, NotIn, Prefix or Regex, which is applied to the values of the selector.
+	// +optional
+	Operator SelectorOperator `json:"operator,omitempty"`
+
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// SchedulingExplanation describes how the ServiceTargetConfig of a LandscaperDeployment has been selected.
+type SchedulingExplanation struct {
+	// MatchedRules are the scheduling rules with the highest priority which match the LandscaperDeployment.
+	// +optional
+	MatchedRules []MatchedSchedulingRule `json:"matchedRules,omitempty"`
+
+	// Unrestricted is true if no scheduling rule matched and the unrestricted ServiceTargetConfigs have been considered.
+	// +optional
+	Unrestricted bool `json:"unrestricted,omitempty"`
+
+	// Candidates are the ServiceTargetConfigs which passed all filters, sorted descending by their score.
+	// +optional
+	Candidates []SchedulingCandidate `json:"candidates,omitempty"`
+
+	// FilteredOut are the ServiceTargetConfigs which have been excluded, together with the reason.
+	// +optional
+	FilteredOut []FilteredServiceTargetConfig `json:"filteredOut,omitempty"`
+
+	// Spread is the spread policy which has been applied.
+	// +optional
+	Spread *SpreadPolicy `json:"spread,omitempty"`
+
+	// Selected references the selected ServiceTargetConfig.
+	// +optional
+	Selected *ObjectReference `json:"selected,omitempty"`
+}
+
+// MatchedSchedulingRule identifies a scheduling rule which matches a LandscaperDeployment.
+type MatchedSchedulingRule struct {
+	// Index is the index of the rule in the rules of the TargetScheduling.
+	Index int `json:"index"`
+	// Priority is the priority of the rule.
+	Priority int64 `json:"priority"`
+}
+
+// SchedulingCandidate is a ServiceTargetConfig which can host a LandscaperDeployment.
+type SchedulingCandidate struct {
+	ObjectReference `json:",inline"`
+	// Priority is the priority of the ServiceTargetConfig.
+	Priority int64 `json:"priority"`
+	// InstanceCount is the number of instances already scheduled on the ServiceTargetConfig.
+	InstanceCount int `json:"instanceCount"`
+	// SpreadCount is the number of instances on the ServiceTargetConfig with the same tenant id or label value.
+	// It is only set if a spread policy is applied.
+	// +optional
+	SpreadCount *int `json:"spreadCount,omitempty"`
+	// AffinityWeight is the sum of the weights of the preferred topology requirements
+	// of the LandscaperDeployment which are fulfilled by the ServiceTargetConfig.
+	// +optional
+	AffinityWeight int64 `json:"affinityWeight,omitempty"`
+	// Score is the priority divided by the instance count + 1, and with a soft spread policy also by the spread count + 1.
+	// It is increased by the affinity weight in percent. The candidate with the highest score is selected.
+	Score string `json:"score"`
+}
+
+// FilteredServiceTargetConfig is a ServiceTargetConfig which has been excluded from scheduling.
+type FilteredServiceTargetConfig struct {
+	ObjectReference `json:",inline"`
+	// Reason is the reason why the ServiceTargetConfig has been excluded.
+	Reason string `json:"reason"`
+	// Message is a human-readable description of the reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	// SchedulingReasonNotFound is set if a scheduling rule references a ServiceTargetConfig which does not exist or is not visible.
+	SchedulingReasonNotFound = "NotFound"
+	// SchedulingReasonCordoned is set if the ServiceTargetConfig is cordoned.
+	SchedulingReasonCordoned = "Cordoned"
+	// SchedulingReasonNotReady is set if the target cluster of the ServiceTargetConfig has been probed as not ready.
+	SchedulingReasonNotReady = "NotReady"
+	// SchedulingReasonRestricted is set if no scheduling rule matched and the ServiceTargetConfig is restricted.
+	SchedulingReasonRestricted = "Restricted"
+	// SchedulingReasonNoCapacity is set if the ServiceTargetConfig has not enough capacity left.
+	SchedulingReasonNoCapacity = "NoCapacity"
+	// SchedulingReasonSpreadConstraint is set if the ServiceTargetConfig would violate a hard spread policy.
+	SchedulingReasonSpreadConstraint = "SpreadConstraint"
+	// SchedulingReasonTopologyAffinity is set if the ServiceTargetConfig doesn't fulfill the required topology affinity.
+	SchedulingReasonTopologyAffinity = "TopologyAffinity"
+)
@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -30,6 +31,22 @@ func (in *AutomaticReconcile) DeepCopy() *AutomaticReconcile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Availability) DeepCopyInto(out *Availability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Availability.
+func (in *Availability) DeepCopy() *Availability {
+	if in == nil {
+		return nil
+	}
+	out := new(Availability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityCollection) DeepCopyInto(out *AvailabilityCollection) {
 	*out = *in
@@ -117,6 +134,13 @@ func (in *AvailabilityCollectionStatus) DeepCopyInto(out *AvailabilityCollection
 	*out = *in
 	in.LastRun.DeepCopyInto(&out.LastRun)
 	in.LastReported.DeepCopyInto(&out.LastReported)
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]AvailabilitySinkStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Instances != nil {
 		in, out := &in.Instances, &out.Instances
 		*out = make([]AvailabilityInstance, len(*in))
@@ -146,6 +170,31 @@ func (in *AvailabilityInstance) DeepCopyInto(out *AvailabilityInstance) {
 		in, out := &in.FailedSince, &out.FailedSince
 		*out = (*in).DeepCopy()
 	}
+	if in.DataPlaneFailedSince != nil {
+		in, out := &in.DataPlaneFailedSince, &out.DataPlaneFailedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(InstanceIdentity)
+		**out = **in
+	}
+	if in.MonitoredSince != nil {
+		in, out := &in.MonitoredSince, &out.MonitoredSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Outages != nil {
+		in, out := &in.Outages, &out.Outages
+		*out = make([]Outage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(Availability)
+		**out = **in
+	}
 	return
 }
 
@@ -159,6 +208,54 @@ func (in *AvailabilityInstance) DeepCopy() *AvailabilityInstance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilitySinkStatus) DeepCopyInto(out *AvailabilitySinkStatus) {
+	*out = *in
+	in.LastReported.DeepCopyInto(&out.LastReported)
+	if in.Backlog != nil {
+		in, out := &in.Backlog, &out.Backlog
+		*out = make([]AvailabilitySnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilitySinkStatus.
+func (in *AvailabilitySinkStatus) DeepCopy() *AvailabilitySinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilitySinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilitySnapshot) DeepCopyInto(out *AvailabilitySnapshot) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.FailedInstances != nil {
+		in, out := &in.FailedInstances, &out.FailedInstances
+		*out = make([]FailedAvailabilityInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilitySnapshot.
+func (in *AvailabilitySnapshot) DeepCopy() *AvailabilitySnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilitySnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Controller) DeepCopyInto(out *Controller) {
 	*out = *in
@@ -201,6 +298,27 @@ func (in *Controllers) DeepCopy() *Controllers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cordon) DeepCopyInto(out *Cordon) {
+	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(Drain)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cordon.
+func (in *Cordon) DeepCopy() *Cordon {
+	if in == nil {
+		return nil
+	}
+	out := new(Cordon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlane) DeepCopyInto(out *DataPlane) {
 	*out = *in
@@ -295,6 +413,49 @@ func (in *DeployerConfig) DeepCopy() *DeployerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Drain) DeepCopyInto(out *Drain) {
+	*out = *in
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Drain.
+func (in *Drain) DeepCopy() *Drain {
+	if in == nil {
+		return nil
+	}
+	out := new(Drain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainStatus) DeepCopyInto(out *DrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.MigratingInstances != nil {
+		in, out := &in.MigratingInstances, &out.MigratingInstances
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainStatus.
+func (in *DrainStatus) DeepCopy() *DrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Error) DeepCopyInto(out *Error) {
 	*out = *in
@@ -313,6 +474,45 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedAvailabilityInstance) DeepCopyInto(out *FailedAvailabilityInstance) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(InstanceIdentity)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedAvailabilityInstance.
+func (in *FailedAvailabilityInstance) DeepCopy() *FailedAvailabilityInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedAvailabilityInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilteredServiceTargetConfig) DeepCopyInto(out *FilteredServiceTargetConfig) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilteredServiceTargetConfig.
+func (in *FilteredServiceTargetConfig) DeepCopy() *FilteredServiceTargetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FilteredServiceTargetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPA) DeepCopyInto(out *HPA) {
 	*out = *in
@@ -329,6 +529,75 @@ func (in *HPA) DeepCopy() *HPA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
 	*out = *in
@@ -373,6 +642,22 @@ func (in *Instance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIdentity) DeepCopyInto(out *InstanceIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIdentity.
+func (in *InstanceIdentity) DeepCopy() *InstanceIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceList) DeepCopyInto(out *InstanceList) {
 	*out = *in
@@ -406,6 +691,26 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMigrationStatus) DeepCopyInto(out *InstanceMigrationStatus) {
+	*out = *in
+	out.SourceServiceTargetConfigRef = in.SourceServiceTargetConfigRef
+	out.TargetServiceTargetConfigRef = in.TargetServiceTargetConfigRef
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationStatus.
+func (in *InstanceMigrationStatus) DeepCopy() *InstanceMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 	*out = *in
@@ -431,6 +736,16 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = new(DataPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceTimeWindow != nil {
+		in, out := &in.MaintenanceTimeWindow, &out.MaintenanceTimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -455,7 +770,17 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 	if in.LandscaperServiceComponent != nil {
 		in, out := &in.LandscaperServiceComponent, &out.LandscaperServiceComponent
 		*out = new(LandscaperServiceComponent)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContextRef != nil {
 		in, out := &in.ContextRef, &out.ContextRef
@@ -477,11 +802,41 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.UserKubeconfigSecretRef != nil {
+		in, out := &in.UserKubeconfigSecretRef, &out.UserKubeconfigSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.UserKubeconfigExpirationTime != nil {
+		in, out := &in.UserKubeconfigExpirationTime, &out.UserKubeconfigExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AdminKubeconfigSecretRef != nil {
+		in, out := &in.AdminKubeconfigSecretRef, &out.AdminKubeconfigSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.AdminKubeconfigExpirationTime != nil {
+		in, out := &in.AdminKubeconfigExpirationTime, &out.AdminKubeconfigExpirationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.ExternalDataPlaneClusterRef != nil {
 		in, out := &in.ExternalDataPlaneClusterRef, &out.ExternalDataPlaneClusterRef
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(InstanceMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -540,6 +895,11 @@ func (in *K8SClientSettings) DeepCopy() *K8SClientSettings {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LabelSelector) DeepCopyInto(out *LabelSelector) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -720,6 +1080,21 @@ func (in *LandscaperDeploymentSpec) DeepCopyInto(out *LandscaperDeploymentSpec)
 		*out = new(DataPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceTimeWindow != nil {
+		in, out := &in.MaintenanceTimeWindow, &out.MaintenanceTimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologyAffinity != nil {
+		in, out := &in.TopologyAffinity, &out.TopologyAffinity
+		*out = new(TopologyAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -746,6 +1121,33 @@ func (in *LandscaperDeploymentStatus) DeepCopyInto(out *LandscaperDeploymentStat
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.LandscaperServiceComponent != nil {
+		in, out := &in.LandscaperServiceComponent, &out.LandscaperServiceComponent
+		*out = new(LandscaperServiceComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingExplanation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -762,6 +1164,10 @@ func (in *LandscaperDeploymentStatus) DeepCopy() *LandscaperDeploymentStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperServiceComponent) DeepCopyInto(out *LandscaperServiceComponent) {
 	*out = *in
+	if in.RemovalTime != nil {
+		in, out := &in.RemovalTime, &out.RemovalTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -776,7 +1182,64 @@ func (in *LandscaperServiceComponent) DeepCopy() *LandscaperServiceComponent {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceRegistration) DeepCopyInto(out *NamespaceRegistration) {
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
+	*out = *in
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextMaintenanceTime != nil {
+		in, out := &in.NextMaintenanceTime, &out.NextMaintenanceTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedSchedulingRule) DeepCopyInto(out *MatchedSchedulingRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedSchedulingRule.
+func (in *MatchedSchedulingRule) DeepCopy() *MatchedSchedulingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedSchedulingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceRegistration) DeepCopyInto(out *NamespaceRegistration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -860,6 +1323,13 @@ func (in *NamespaceRegistrationStatus) DeepCopyInto(out *NamespaceRegistrationSt
 		*out = new(Error)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -905,6 +1375,201 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Outage) DeepCopyInto(out *Outage) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Outage.
+func (in *Outage) DeepCopy() *Outage {
+	if in == nil {
+		return nil
+	}
+	out := new(Outage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurposeSelector) DeepCopyInto(out *PurposeSelector) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurposeSelector.
+func (in *PurposeSelector) DeepCopy() *PurposeSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PurposeSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalanceMove) DeepCopyInto(out *RebalanceMove) {
+	*out = *in
+	out.InstanceRef = in.InstanceRef
+	out.SourceServiceTargetConfigRef = in.SourceServiceTargetConfigRef
+	out.TargetServiceTargetConfigRef = in.TargetServiceTargetConfigRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalanceMove.
+func (in *RebalanceMove) DeepCopy() *RebalanceMove {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalanceMove)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalanceMoveStatus) DeepCopyInto(out *RebalanceMoveStatus) {
+	*out = *in
+	out.InstanceRef = in.InstanceRef
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalanceMoveStatus.
+func (in *RebalanceMoveStatus) DeepCopy() *RebalanceMoveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalanceMoveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancePlan) DeepCopyInto(out *RebalancePlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlan.
+func (in *RebalancePlan) DeepCopy() *RebalancePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RebalancePlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancePlanList) DeepCopyInto(out *RebalancePlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RebalancePlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanList.
+func (in *RebalancePlanList) DeepCopy() *RebalancePlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancePlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RebalancePlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancePlanSpec) DeepCopyInto(out *RebalancePlanSpec) {
+	*out = *in
+	if in.Moves != nil {
+		in, out := &in.Moves, &out.Moves
+		*out = make([]RebalanceMove, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanSpec.
+func (in *RebalancePlanSpec) DeepCopy() *RebalancePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancePlanStatus) DeepCopyInto(out *RebalancePlanStatus) {
+	*out = *in
+	if in.LastProposalTime != nil {
+		in, out := &in.LastProposalTime, &out.LastProposalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastMigrationTime != nil {
+		in, out := &in.LastMigrationTime, &out.LastMigrationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Moves != nil {
+		in, out := &in.Moves, &out.Moves
+		*out = make([]RebalanceMoveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanStatus.
+func (in *RebalancePlanStatus) DeepCopy() *RebalancePlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancePlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRequests) DeepCopyInto(out *ResourceRequests) {
 	*out = *in
@@ -938,6 +1603,71 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingCandidate) DeepCopyInto(out *SchedulingCandidate) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	if in.SpreadCount != nil {
+		in, out := &in.SpreadCount, &out.SpreadCount
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingCandidate.
+func (in *SchedulingCandidate) DeepCopy() *SchedulingCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanation) DeepCopyInto(out *SchedulingExplanation) {
+	*out = *in
+	if in.MatchedRules != nil {
+		in, out := &in.MatchedRules, &out.MatchedRules
+		*out = make([]MatchedSchedulingRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]SchedulingCandidate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FilteredOut != nil {
+		in, out := &in.FilteredOut, &out.FilteredOut
+		*out = make([]FilteredServiceTargetConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spread != nil {
+		in, out := &in.Spread, &out.Spread
+		*out = new(SpreadPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selected != nil {
+		in, out := &in.Selected, &out.Selected
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanation.
+func (in *SchedulingExplanation) DeepCopy() *SchedulingExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingRule) DeepCopyInto(out *SchedulingRule) {
 	*out = *in
@@ -953,6 +1683,11 @@ func (in *SchedulingRule) DeepCopyInto(out *SchedulingRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Spread != nil {
+		in, out := &in.Spread, &out.Spread
+		*out = new(SpreadPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -989,12 +1724,17 @@ func (in *Selector) DeepCopyInto(out *Selector) {
 	if in.MatchTenant != nil {
 		in, out := &in.MatchTenant, &out.MatchTenant
 		*out = new(TenantSelector)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MatchLabel != nil {
 		in, out := &in.MatchLabel, &out.MatchLabel
 		*out = new(LabelSelector)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchPurpose != nil {
+		in, out := &in.MatchPurpose, &out.MatchPurpose
+		*out = new(PurposeSelector)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Or != nil {
 		in, out := &in.Or, &out.Or
@@ -1033,7 +1773,7 @@ func (in *ServiceTargetConfig) DeepCopyInto(out *ServiceTargetConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1093,6 +1833,26 @@ func (in *ServiceTargetConfigList) DeepCopyObject() runtime.Object {
 func (in *ServiceTargetConfigSpec) DeepCopyInto(out *ServiceTargetConfigSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.MaxInstances != nil {
+		in, out := &in.MaxInstances, &out.MaxInstances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResourceBudget != nil {
+		in, out := &in.ResourceBudget, &out.ResourceBudget
+		*out = new(ResourceRequests)
+		**out = **in
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(Topology)
+		**out = **in
+	}
+	if in.Cordon != nil {
+		in, out := &in.Cordon, &out.Cordon
+		*out = new(Cordon)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1114,6 +1874,22 @@ func (in *ServiceTargetConfigStatus) DeepCopyInto(out *ServiceTargetConfigStatus
 		*out = make([]ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1127,6 +1903,27 @@ func (in *ServiceTargetConfigStatus) DeepCopy() *ServiceTargetConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpreadPolicy) DeepCopyInto(out *SpreadPolicy) {
+	*out = *in
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpreadPolicy.
+func (in *SpreadPolicy) DeepCopy() *SpreadPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SpreadPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subject) DeepCopyInto(out *Subject) {
 	*out = *in
@@ -1332,6 +2129,11 @@ func (in *TargetSchedulingSpec) DeepCopy() *TargetSchedulingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TenantSelector) DeepCopyInto(out *TenantSelector) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1344,3 +2146,87 @@ func (in *TenantSelector) DeepCopy() *TenantSelector {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Topology) DeepCopyInto(out *Topology) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topology.
+func (in *Topology) DeepCopy() *Topology {
+	if in == nil {
+		return nil
+	}
+	out := new(Topology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyAffinity) DeepCopyInto(out *TopologyAffinity) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = make([]TopologyRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Preferred != nil {
+		in, out := &in.Preferred, &out.Preferred
+		*out = make([]WeightedTopologyRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyAffinity.
+func (in *TopologyAffinity) DeepCopy() *TopologyAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyRequirement) DeepCopyInto(out *TopologyRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyRequirement.
+func (in *TopologyRequirement) DeepCopy() *TopologyRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedTopologyRequirement) DeepCopyInto(out *WeightedTopologyRequirement) {
+	*out = *in
+	in.TopologyRequirement.DeepCopyInto(&out.TopologyRequirement)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedTopologyRequirement.
+func (in *WeightedTopologyRequirement) DeepCopy() *WeightedTopologyRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedTopologyRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
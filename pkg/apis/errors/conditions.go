@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// ReasonUnknownError is the condition reason used for errors that are not landscaper service errors.
+	ReasonUnknownError = "UnknownError"
+	// ReasonReady is the condition reason used when all conditions of a resource are true.
+	ReasonReady = "Ready"
+)
+
+// SetCondition sets the status, reason and message of the condition with the given type.
+// The last transition time is only updated when the status changes.
+func SetCondition(conditions *[]metav1.Condition, conditionType string, status metav1.ConditionStatus, reason, message string, observedGeneration int64) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             ConditionReason(reason),
+		Message:            message,
+		ObservedGeneration: observedGeneration,
+	})
+}
+
+// SetErrorCondition sets the condition with the given type to false.
+// If the error is a landscaper service error, its reason and message are used for the condition.
+func SetErrorCondition(conditions *[]metav1.Condition, conditionType string, err error, observedGeneration int64) {
+	reason := ReasonUnknownError
+	message := err.Error()
+	if lssErr, ok := IsError(err); ok {
+		reason = lssErr.lssErr.Reason
+		message = lssErr.lssErr.Message
+	}
+	SetCondition(conditions, conditionType, metav1.ConditionFalse, reason, message, observedGeneration)
+}
+
+// SetReadyCondition sets the ready condition based on the conditions with the given types.
+// The ready condition is true when all of these conditions are true.
+// Otherwise, the reason and message of the first condition that is not true are copied.
+// Conditions that have not been set yet are regarded as not true.
+func SetReadyCondition(conditions *[]metav1.Condition, observedGeneration int64, conditionTypes ...string) {
+	for _, conditionType := range conditionTypes {
+		condition := meta.FindStatusCondition(*conditions, conditionType)
+		if condition == nil {
+			SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionFalse, "Pending",
+				"condition "+conditionType+" has not been set", observedGeneration)
+			return
+		}
+		if condition.Status != metav1.ConditionTrue {
+			SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionFalse, condition.Reason, condition.Message, observedGeneration)
+			return
+		}
+	}
+	SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionTrue, ReasonReady, "", observedGeneration)
+}
+
+// ConditionReason converts the given string into a valid condition reason, which has to be in CamelCase.
+// Whitespace separated words are capitalized and joined, all other invalid characters are removed.
+func ConditionReason(reason string) string {
+	var b strings.Builder
+	for _, word := range strings.Fields(reason) {
+		upper := true
+		for _, r := range word {
+			if !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == ',' || r == ':')) {
+				continue
+			}
+			if b.Len() == 0 && !unicode.IsLetter(r) {
+				continue
+			}
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			b.WriteRune(r)
+		}
+	}
+
+	result := strings.TrimRight(b.String(), ",:")
+	if len(result) == 0 {
+		return ReasonUnknownError
+	}
+	return result
+}
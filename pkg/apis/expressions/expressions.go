@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package expressions
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/lru"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// This file implements the CEL expressions of TargetScheduling selectors, see docs/usage/TargetScheduling.md.
+
+const (
+	// ObjectVariable is the name of the variable which holds the LandscaperDeployment in a selector expression.
+	ObjectVariable = "object"
+
+	// costLimit limits the runtime cost of a selector expression, so that a single expression can't block the scheduling.
+	costLimit = 1000000
+
+	// programCacheSize is the maximum number of compiled selector expressions which are cached for the evaluation.
+	programCacheSize = 256
+
+	// noSuchKeyError is the prefix of the evaluation error of a field or map key which does not exist.
+	noSuchKeyError = "no such key: "
+)
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs caches the compiled selector expressions, as the same expressions are evaluated for every LandscaperDeployment.
+	programs = lru.New(programCacheSize)
+)
+
+// getEnv returns the expression environment, in which the object variable is declared with the type of a LandscaperDeployment.
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		registry, err := types.NewRegistry()
+		if err != nil {
+			envErr = err
+			return
+		}
+		provider, objectType, err := newObjectTypes(registry, reflect.TypeOf(v1alpha1.LandscaperDeployment{}))
+		if err != nil {
+			envErr = err
+			return
+		}
+		env, envErr = cel.NewEnv(cel.CustomTypeProvider(provider), cel.Variable(ObjectVariable, objectType))
+	})
+	return env, envErr
+}
+
+// CompileSelectorExpression compiles and type-checks a selector expression.
+// The expression must evaluate to a bool and may only access the fields of a LandscaperDeployment.
+func CompileSelectorExpression(expression string) (cel.Program, error) {
+	celEnv, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create expression environment: %w", err)
+	}
+
+	ast, issues := celEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to bool, but evaluates to %s", ast.OutputType())
+	}
+
+	return celEnv.Program(ast, cel.CostLimit(costLimit))
+}
+
+// EvaluateSelectorExpression evaluates a selector expression for the given LandscaperDeployment.
+// If a field or map key does not exist in the LandscaperDeployment, the expression doesn't match.
+// All other evaluation errors, for example an exceeded cost limit, are returned.
+func EvaluateSelectorExpression(expression string, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
+	var program cel.Program
+	if cached, ok := programs.Get(expression); ok {
+		program = cached.(cel.Program)
+	} else {
+		compiled, err := CompileSelectorExpression(expression)
+		if err != nil {
+			return false, fmt.Errorf("invalid expression %q: %w", expression, err)
+		}
+		programs.Add(expression, compiled)
+		program = compiled
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	if err != nil {
+		return false, fmt.Errorf("unable to convert landscaper deployment: %w", err)
+	}
+
+	result, _, err := program.Eval(map[string]interface{}{ObjectVariable: object})
+	if err != nil {
+		if strings.HasPrefix(err.Error(), noSuchKeyError) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to evaluate expression %q: %w", expression, err)
+	}
+
+	match, ok := result.Value().(bool)
+	return ok && match, nil
+}
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package expressions
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/common/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+	// stringTypes are the API types with a custom json serialization, which are serialized as strings.
+	stringTypes = map[reflect.Type]bool{
+		reflect.TypeOf(metav1.Time{}):      true,
+		reflect.TypeOf(metav1.MicroTime{}): true,
+		reflect.TypeOf(metav1.Duration{}):  true,
+	}
+)
+
+// objectTypes is a CEL type provider, which declares the object types of a Go API type and all its nested struct types.
+// The fields and their types follow the json serialization of the API type, so that an expression is type-checked
+// against the fields of the unstructured object on which it is evaluated.
+// Other types are resolved by the embedded provider.
+type objectTypes struct {
+	types.Provider
+
+	fields map[string]map[string]*types.Type
+	names  map[reflect.Type]string
+}
+
+// newObjectTypes creates the type provider for the given Go API type.
+// It returns the CEL object type of the API type.
+func newObjectTypes(provider types.Provider, apiType reflect.Type) (*objectTypes, *types.Type, error) {
+	objTypes := &objectTypes{
+		Provider: provider,
+		fields:   map[string]map[string]*types.Type{},
+		names:    map[reflect.Type]string{},
+	}
+
+	objType, err := objTypes.celType(apiType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return objTypes, objType, nil
+}
+
+// FindStructType implements types.Provider.
+func (o *objectTypes) FindStructType(structType string) (*types.Type, bool) {
+	if _, ok := o.fields[structType]; ok {
+		return types.NewTypeTypeWithParam(types.NewObjectType(structType)), true
+	}
+	return o.Provider.FindStructType(structType)
+}
+
+// FindStructFieldNames implements types.Provider.
+func (o *objectTypes) FindStructFieldNames(structType string) ([]string, bool) {
+	fields, ok := o.fields[structType]
+	if !ok {
+		return o.Provider.FindStructFieldNames(structType)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// FindStructFieldType implements types.Provider.
+// The returned field types don't provide accessors, so the fields are selected from the unstructured object at runtime.
+func (o *objectTypes) FindStructFieldType(structType, fieldName string) (*types.FieldType, bool) {
+	fields, ok := o.fields[structType]
+	if !ok {
+		return o.Provider.FindStructFieldType(structType, fieldName)
+	}
+
+	fieldType, ok := fields[fieldName]
+	if !ok {
+		return nil, false
+	}
+	return &types.FieldType{Type: fieldType}, true
+}
+
+// celType returns the CEL type of the json serialization of the given Go type.
+func (o *objectTypes) celType(t reflect.Type) (*types.Type, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if stringTypes[t] {
+		return types.StringType, nil
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return types.DynType, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return types.StringType, nil
+	case reflect.Bool:
+		return types.BoolType, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return types.IntType, nil
+	case reflect.Uint, reflect.Uint64:
+		return types.UintType, nil
+	case reflect.Float32, reflect.Float64:
+		return types.DoubleType, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are serialized as base64 encoded strings
+			return types.StringType, nil
+		}
+		elemType, err := o.celType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewListType(elemType), nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+		}
+		valueType, err := o.celType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMapType(types.StringType, valueType), nil
+	case reflect.Struct:
+		return o.structType(t)
+	case reflect.Interface:
+		return types.DynType, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// structType declares the object type of the given Go struct type.
+func (o *objectTypes) structType(t reflect.Type) (*types.Type, error) {
+	if name, ok := o.names[t]; ok {
+		return types.NewObjectType(name), nil
+	}
+	if t.Name() == "" {
+		return types.DynType, nil
+	}
+
+	name := path.Base(t.PkgPath()) + "." + t.Name()
+	if _, ok := o.fields[name]; ok {
+		return nil, fmt.Errorf("type %s conflicts with another type named %s", t, name)
+	}
+
+	// the type is registered before its fields are resolved, so that recursive types are supported
+	fields := map[string]*types.Type{}
+	o.names[t] = name
+	o.fields[name] = fields
+
+	if err := o.addFields(fields, t); err != nil {
+		return nil, err
+	}
+	return types.NewObjectType(name), nil
+}
+
+// addFields adds the json serialized fields of the given Go struct type, including the fields of inlined structs.
+func (o *objectTypes) addFields(fields map[string]*types.Type, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if name == "" && field.Anonymous && (opts == "inline" || tag == "") {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := o.addFields(fields, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldType, err := o.celType(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s of %s: %w", field.Name, t, err)
+		}
+		fields[name] = fieldType
+	}
+	return nil
+}
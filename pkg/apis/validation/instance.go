@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,23 +20,56 @@ const (
 	InstanceIdLength = 8
 )
 
-// ValidateInstance validates an instance
-func ValidateInstance(instance *v1alpha1.Instance, oldInstance *v1alpha1.Instance) field.ErrorList {
+// ValidateInstance validates an instance.
+// The landscaper version is validated against the given supported versions.
+func ValidateInstance(instance *v1alpha1.Instance, oldInstance *v1alpha1.Instance, supportedVersions versions.SupportedVersions) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateInstanceObjectMeta(&instance.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateInstanceSpec(&instance.Spec, field.NewPath("spec"))...)
 	if oldInstance != nil {
-		allErrs = append(allErrs, validateInstanceSpecUpdate(&instance.Spec, &oldInstance.Spec, field.NewPath("spec"))...)
+		allErrs = append(allErrs, validateInstanceSpecUpdate(&instance.Spec, &oldInstance.Spec, getMigrationTarget(oldInstance), field.NewPath("spec"))...)
 	}
+	allErrs = append(allErrs, validateInstanceVersion(instance, oldInstance, supportedVersions, field.NewPath("spec").Child("landscaperVersion"))...)
 	return allErrs
 }
 
+// validateInstanceVersion validates the landscaper version against the version which is installed for the instance.
+func validateInstanceVersion(instance *v1alpha1.Instance, oldInstance *v1alpha1.Instance, supportedVersions versions.SupportedVersions, fldPath *field.Path) field.ErrorList {
+	var oldVersion, currentVersion string
+	if oldInstance != nil {
+		oldVersion = oldInstance.Spec.LandscaperVersion
+		currentVersion = oldVersion
+		if oldInstance.Status.LandscaperServiceComponent != nil {
+			currentVersion = oldInstance.Status.LandscaperServiceComponent.Version
+		}
+	}
+	return ValidateLandscaperVersion(instance.Spec.LandscaperVersion, oldVersion, currentVersion, supportedVersions, fldPath)
+}
+
 func validateInstanceObjectMeta(objMeta *metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(objMeta, true, apivalidation.NameIsDNSLabel, fldPath)...)
+
+	if value, ok := objMeta.Annotations[v1alpha1.InstanceMigrationAnnotation]; ok {
+		if _, err := v1alpha1.ParseObjectReference(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("annotations").Key(v1alpha1.InstanceMigrationAnnotation), value, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
+// getMigrationTarget returns the service target config to which the service target config reference of the instance
+// may be changed. This is only the target of a running migration, while the migration switches the service target config.
+// Returns nil if the service target config reference must not be changed.
+func getMigrationTarget(instance *v1alpha1.Instance) *v1alpha1.ObjectReference {
+	migration := instance.Status.Migration
+	if migration == nil || migration.Phase != v1alpha1.InstanceMigrationPhaseSwitchingServiceTargetConfig {
+		return nil
+	}
+	return &migration.TargetServiceTargetConfigRef
+}
+
 func validateInstanceSpec(spec *v1alpha1.InstanceSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateObjectReference(&spec.ServiceTargetConfigRef, fldPath.Child("serviceTargetConfigRef"))...)
@@ -60,10 +94,18 @@ func validateInstanceSpec(spec *v1alpha1.InstanceSpec, fldPath *field.Path) fiel
 		allErrs = append(allErrs, ValidateDataPlane(spec.DataPlane, fldPath.Child("dataPlane"))...)
 	}
 
+	if spec.MaintenanceTimeWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceTimeWindow(spec.MaintenanceTimeWindow, fldPath.Child("maintenanceTimeWindow"))...)
+	}
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	}
+
 	return allErrs
 }
 
-func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.InstanceSpec, fldPath *field.Path) field.ErrorList {
+func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.InstanceSpec, migrationTarget *v1alpha1.ObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if spec.TenantId != oldSpec.TenantId {
@@ -74,8 +116,8 @@ func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.I
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "is immutable"))
 	}
 
-	if !spec.ServiceTargetConfigRef.Equals(&oldSpec.ServiceTargetConfigRef) {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"), "is immutable"))
+	if !spec.ServiceTargetConfigRef.Equals(&oldSpec.ServiceTargetConfigRef) && (migrationTarget == nil || !spec.ServiceTargetConfigRef.Equals(migrationTarget)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"), fmt.Sprintf("is immutable, use the %s annotation to migrate the instance", v1alpha1.InstanceMigrationAnnotation)))
 	}
 
 	if spec.HighAvailabilityConfig != nil && oldSpec.HighAvailabilityConfig != nil {
@@ -6,12 +6,14 @@ package validation
 
 import (
 	"fmt"
+	"slices"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 )
 
 const (
@@ -19,17 +21,33 @@ const (
 	LandscaperDeploymentTenantIdLength = 8
 )
 
-// ValidateLandscaperDeployment validates a LandscaperDeployment
-func ValidateLandscaperDeployment(deployment *v1alpha1.LandscaperDeployment, oldDeployment *v1alpha1.LandscaperDeployment) field.ErrorList {
+// ValidateLandscaperDeployment validates a LandscaperDeployment.
+// The landscaper version is validated against the given supported versions.
+func ValidateLandscaperDeployment(deployment *v1alpha1.LandscaperDeployment, oldDeployment *v1alpha1.LandscaperDeployment, supportedVersions versions.SupportedVersions) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateLandscaperDeploymentObjectMeta(&deployment.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateLandscaperDeploymentSpec(&deployment.Spec, field.NewPath("spec"))...)
 	if oldDeployment != nil {
 		allErrs = append(allErrs, validateLandscaperDeploymentSpecUpdate(&deployment.Spec, &oldDeployment.Spec, field.NewPath("spec"))...)
 	}
+	allErrs = append(allErrs, validateLandscaperDeploymentVersion(deployment, oldDeployment, supportedVersions, field.NewPath("spec").Child("landscaperVersion"))...)
 	return allErrs
 }
 
+// validateLandscaperDeploymentVersion validates the landscaper version against the version which is installed
+// by the instance of the deployment, which is mirrored into the deployment status.
+func validateLandscaperDeploymentVersion(deployment *v1alpha1.LandscaperDeployment, oldDeployment *v1alpha1.LandscaperDeployment, supportedVersions versions.SupportedVersions, fldPath *field.Path) field.ErrorList {
+	var oldVersion, currentVersion string
+	if oldDeployment != nil {
+		oldVersion = oldDeployment.Spec.LandscaperVersion
+		currentVersion = oldVersion
+		if oldDeployment.Status.LandscaperServiceComponent != nil {
+			currentVersion = oldDeployment.Status.LandscaperServiceComponent.Version
+		}
+	}
+	return ValidateLandscaperVersion(deployment.Spec.LandscaperVersion, oldVersion, currentVersion, supportedVersions, fldPath)
+}
+
 func validateLandscaperDeploymentObjectMeta(objMeta *metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(objMeta, true, apivalidation.NameIsDNSLabel, fldPath)...)
@@ -59,11 +77,68 @@ func validateLandscaperDeploymentSpec(spec *v1alpha1.LandscaperDeploymentSpec, f
 		allErrs = append(allErrs, ValidateDataPlane(spec.DataPlane, fldPath.Child("dataPlane"))...)
 	}
 
+	if spec.MaintenanceTimeWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceTimeWindow(spec.MaintenanceTimeWindow, fldPath.Child("maintenanceTimeWindow"))...)
+	}
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	}
+
+	if spec.TopologyAffinity != nil {
+		allErrs = append(allErrs, ValidateTopologyAffinity(spec.TopologyAffinity, fldPath.Child("topologyAffinity"))...)
+	}
+
 	allErrs = append(allErrs, ValidateLandscaperConfiguration(&spec.LandscaperConfiguration, fldPath.Child("landscaperConfiguration"))...)
 
 	return allErrs
 }
 
+var (
+	// topologyKeys are the supported keys of topology requirements
+	topologyKeys = []string{string(v1alpha1.TopologyKeyRegion), string(v1alpha1.TopologyKeyProvider), string(v1alpha1.TopologyKeyZone)}
+	// topologyOperators are the supported operators of topology requirements
+	topologyOperators = []string{string(v1alpha1.SelectorOpIn), string(v1alpha1.SelectorOpNotIn), string(v1alpha1.SelectorOpPrefix)}
+)
+
+// ValidateTopologyAffinity validates the topology affinity of a LandscaperDeployment.
+func ValidateTopologyAffinity(affinity *v1alpha1.TopologyAffinity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i := range affinity.Required {
+		allErrs = append(allErrs, validateTopologyRequirement(&affinity.Required[i], fldPath.Child("required").Index(i))...)
+	}
+
+	for i := range affinity.Preferred {
+		preferred := &affinity.Preferred[i]
+		preferredPath := fldPath.Child("preferred").Index(i)
+		if preferred.Weight < 1 || preferred.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(preferredPath.Child("weight"), preferred.Weight, "weight must be between 1 and 100"))
+		}
+		allErrs = append(allErrs, validateTopologyRequirement(&preferred.TopologyRequirement, preferredPath)...)
+	}
+
+	return allErrs
+}
+
+func validateTopologyRequirement(requirement *v1alpha1.TopologyRequirement, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !slices.Contains(topologyKeys, string(requirement.Key)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("key"), requirement.Key, topologyKeys))
+	}
+
+	if !slices.Contains(topologyOperators, string(requirement.Operator)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), requirement.Operator, topologyOperators))
+	}
+
+	if len(requirement.Values) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("values"), "at least one value needs to be set"))
+	}
+
+	return allErrs
+}
+
 func validateLandscaperDeploymentSpecUpdate(spec *v1alpha1.LandscaperDeploymentSpec, oldSpec *v1alpha1.LandscaperDeploymentSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/validation"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 )
 
 func createLandscaperDeployment() *v1alpha1.LandscaperDeployment {
@@ -31,23 +32,195 @@ var _ = Describe("Validation of LandscaperDeployments", func() {
 	It("should accept supported deployers", func() {
 		ld := createLandscaperDeployment()
 		ld.Spec.LandscaperConfiguration.Deployers = []string{"manifest", "helm", "container"}
-		errList := validation.ValidateLandscaperDeployment(ld, nil)
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
 		Expect(errList).To(BeEmpty())
 	})
 
 	It("should accept default deployers", func() {
 		ld := createLandscaperDeployment()
 		ld.Spec.LandscaperConfiguration.Deployers = nil
-		errList := validation.ValidateLandscaperDeployment(ld, nil)
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
 		Expect(errList).To(BeEmpty())
 	})
 
 	It("should reject unsupported deployers", func() {
 		ld := createLandscaperDeployment()
 		ld.Spec.LandscaperConfiguration.Deployers = []string{"fantasy-deployer"}
-		errList := validation.ValidateLandscaperDeployment(ld, nil)
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
 		Expect(errList).To(HaveLen(1))
 		Expect(errList[0].Type).To(Equal(field.ErrorTypeNotSupported))
 		Expect(errList[0].Field).To(Equal("spec.landscaperConfiguration.deployers"))
 	})
+
+	It("should accept a valid maintenance time window", func() {
+		ld := createLandscaperDeployment()
+		ld.Spec.MaintenanceTimeWindow = &v1alpha1.MaintenanceTimeWindow{Begin: "220000+0100", End: "010000+0100"}
+		Expect(validation.ValidateLandscaperDeployment(ld, nil, nil)).To(BeEmpty())
+	})
+
+	It("should reject an invalid maintenance time window", func() {
+		ld := createLandscaperDeployment()
+		ld.Spec.MaintenanceTimeWindow = &v1alpha1.MaintenanceTimeWindow{Begin: "22:00", End: "230000+0100"}
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
+		Expect(errList).To(HaveLen(1))
+		Expect(errList[0].Type).To(Equal(field.ErrorTypeInvalid))
+		Expect(errList[0].Field).To(Equal("spec.maintenanceTimeWindow.begin"))
+	})
+
+	It("should accept valid hibernation schedules", func() {
+		ld := createLandscaperDeployment()
+		location := "Europe/Berlin"
+		ld.Spec.Hibernation = &v1alpha1.Hibernation{
+			Schedules: []v1alpha1.HibernationSchedule{{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5", Location: &location}},
+		}
+		Expect(validation.ValidateLandscaperDeployment(ld, nil, nil)).To(BeEmpty())
+	})
+
+	It("should reject invalid hibernation schedules", func() {
+		ld := createLandscaperDeployment()
+		location := "Europe/Nowhere"
+		ld.Spec.Hibernation = &v1alpha1.Hibernation{
+			Schedules: []v1alpha1.HibernationSchedule{{Start: "00 20 * *", End: "00 08 * * 1,2,3,4,5", Location: &location}},
+		}
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
+		Expect(errList).To(HaveLen(2))
+		Expect(errList[0].Type).To(Equal(field.ErrorTypeInvalid))
+		Expect(errList[0].Field).To(Equal("spec.hibernation.schedules[0].start"))
+		Expect(errList[1].Type).To(Equal(field.ErrorTypeInvalid))
+		Expect(errList[1].Field).To(Equal("spec.hibernation.schedules[0].location"))
+	})
+
+	It("should accept a valid topology affinity", func() {
+		ld := createLandscaperDeployment()
+		ld.Spec.TopologyAffinity = &v1alpha1.TopologyAffinity{
+			Required: []v1alpha1.TopologyRequirement{
+				{Key: v1alpha1.TopologyKeyRegion, Operator: v1alpha1.SelectorOpPrefix, Values: []string{"eu-"}},
+			},
+			Preferred: []v1alpha1.WeightedTopologyRequirement{
+				{Weight: 50, TopologyRequirement: v1alpha1.TopologyRequirement{Key: v1alpha1.TopologyKeyProvider, Operator: v1alpha1.SelectorOpIn, Values: []string{"aws"}}},
+			},
+		}
+		Expect(validation.ValidateLandscaperDeployment(ld, nil, nil)).To(BeEmpty())
+	})
+
+	It("should reject an invalid topology affinity", func() {
+		ld := createLandscaperDeployment()
+		ld.Spec.TopologyAffinity = &v1alpha1.TopologyAffinity{
+			Required: []v1alpha1.TopologyRequirement{
+				{Key: "country", Operator: v1alpha1.SelectorOpRegex},
+			},
+			Preferred: []v1alpha1.WeightedTopologyRequirement{
+				{Weight: 0, TopologyRequirement: v1alpha1.TopologyRequirement{Key: v1alpha1.TopologyKeyZone, Operator: v1alpha1.SelectorOpIn, Values: []string{"eu-west-1a"}}},
+			},
+		}
+		errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
+		Expect(errList).To(HaveLen(4))
+		Expect(errList[0].Type).To(Equal(field.ErrorTypeNotSupported))
+		Expect(errList[0].Field).To(Equal("spec.topologyAffinity.required[0].key"))
+		Expect(errList[1].Type).To(Equal(field.ErrorTypeNotSupported))
+		Expect(errList[1].Field).To(Equal("spec.topologyAffinity.required[0].operator"))
+		Expect(errList[2].Type).To(Equal(field.ErrorTypeRequired))
+		Expect(errList[2].Field).To(Equal("spec.topologyAffinity.required[0].values"))
+		Expect(errList[3].Type).To(Equal(field.ErrorTypeInvalid))
+		Expect(errList[3].Field).To(Equal("spec.topologyAffinity.preferred[0].weight"))
+	})
+
+	Context("LandscaperVersion", func() {
+		var supportedVersions versions.SupportedVersions
+
+		BeforeEach(func() {
+			var err error
+			supportedVersions, err = versions.ParseSupportedVersions([]string{"v0.1.15", "v0.3.2", "v1.0.0", "v1.2.3", "v2.1.0"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should accept a supported version", func() {
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "v0.3.2"
+			Expect(validation.ValidateLandscaperDeployment(ld, nil, supportedVersions)).To(BeEmpty())
+		})
+
+		It("should reject an unsupported version", func() {
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "v0.2.0"
+			errList := validation.ValidateLandscaperDeployment(ld, nil, supportedVersions)
+			Expect(errList).To(HaveLen(1))
+			Expect(errList[0].Type).To(Equal(field.ErrorTypeNotSupported))
+			Expect(errList[0].Field).To(Equal("spec.landscaperVersion"))
+		})
+
+		It("should reject an invalid version", func() {
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "latest"
+			errList := validation.ValidateLandscaperDeployment(ld, nil, nil)
+			Expect(errList).To(HaveLen(1))
+			Expect(errList[0].Type).To(Equal(field.ErrorTypeInvalid))
+		})
+
+		It("should only allow upgrades to the supported patch version or the next minor version", func() {
+			oldLd := createLandscaperDeployment()
+			oldLd.Spec.LandscaperVersion = "v0.3.0"
+			oldLd.Status.LandscaperServiceComponent = &v1alpha1.LandscaperServiceComponent{Version: "v0.3.0"}
+
+			for version, allowed := range map[string]bool{
+				"v0.3.2":  true,
+				"v1.0.0":  true,
+				"v0.1.15": false,
+				"v1.2.3":  false,
+				"v2.1.0":  false,
+			} {
+				ld := createLandscaperDeployment()
+				ld.Spec.LandscaperVersion = version
+				errList := validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)
+				if allowed {
+					Expect(errList).To(BeEmpty(), version)
+				} else {
+					Expect(errList).To(HaveLen(1), version)
+					Expect(errList[0].Type).To(Equal(field.ErrorTypeForbidden), version)
+				}
+			}
+		})
+
+		It("should allow an upgrade from an unsupported minor version to the lowest minor version of the next major version", func() {
+			oldLd := createLandscaperDeployment()
+			oldLd.Spec.LandscaperVersion = "v1.5.0"
+
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "v2.1.0"
+			Expect(validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)).To(BeEmpty())
+		})
+
+		It("should use the installed version as current version", func() {
+			oldLd := createLandscaperDeployment()
+			oldLd.Status.LandscaperServiceComponent = &v1alpha1.LandscaperServiceComponent{Version: "v1.0.0"}
+
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "v0.3.2"
+			errList := validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)
+			Expect(errList).To(HaveLen(1))
+			Expect(errList[0].Type).To(Equal(field.ErrorTypeForbidden))
+
+			ld.Spec.LandscaperVersion = "v1.2.3"
+			Expect(validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)).To(BeEmpty())
+		})
+
+		It("should keep a version which is no longer supported", func() {
+			oldLd := createLandscaperDeployment()
+			oldLd.Spec.LandscaperVersion = "v0.2.0"
+
+			ld := createLandscaperDeployment()
+			ld.Spec.LandscaperVersion = "v0.2.0"
+			Expect(validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)).To(BeEmpty())
+		})
+
+		It("should forbid to remove the version", func() {
+			oldLd := createLandscaperDeployment()
+			oldLd.Spec.LandscaperVersion = "v0.3.2"
+
+			ld := createLandscaperDeployment()
+			errList := validation.ValidateLandscaperDeployment(ld, oldLd, supportedVersions)
+			Expect(errList).To(HaveLen(1))
+			Expect(errList[0].Type).To(Equal(field.ErrorTypeForbidden))
+		})
+	})
 })
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
+)
+
+// ValidateLandscaperVersion validates the requested landscaper version of a LandscaperDeployment or an Instance.
+// A new or changed version has to be a supported version and, if the currently installed version is known,
+// an allowed upgrade of it. The check against the supported versions is skipped when these are not known.
+func ValidateLandscaperVersion(version, oldVersion, currentVersion string, supportedVersions versions.SupportedVersions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(version) == 0 {
+		if len(oldVersion) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "may not be removed once it is set"))
+		}
+		return allErrs
+	}
+
+	v, err := versions.ParseVersion(version)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, version, err.Error()))
+		return allErrs
+	}
+
+	if version == oldVersion || len(supportedVersions) == 0 {
+		return allErrs
+	}
+
+	if !supportedVersions.Contains(v) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, version, supportedVersions.Strings()))
+		return allErrs
+	}
+
+	if len(currentVersion) == 0 {
+		return allErrs
+	}
+
+	current, err := versions.ParseVersion(currentVersion)
+	if err != nil {
+		// the installed version is not known to follow semantic versioning, upgrade rules can't be applied
+		return allErrs
+	}
+
+	if !supportedVersions.IsAllowedUpgrade(current, v) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("upgrade from version %s to %s is not allowed, allowed versions are: [%s]",
+			currentVersion, version, strings.Join(supportedVersions.UpgradeVersions(current).Strings(), ", "))))
+	}
+
+	return allErrs
+}
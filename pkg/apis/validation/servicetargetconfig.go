@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -46,5 +47,44 @@ func validateServiceTargetConfigSpec(spec *v1alpha1.ServiceTargetConfigSpec, fld
 		allErrs = append(allErrs, field.Required(fldPath.Child("ingressDomain"), "ingressDomain may not be empty"))
 	}
 
+	if spec.MaxInstances != nil && *spec.MaxInstances < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInstances"), *spec.MaxInstances, "maxInstances must not be negative"))
+	}
+
+	if spec.ResourceBudget != nil {
+		budgetPath := fldPath.Child("resourceBudget")
+		allErrs = append(allErrs, validateQuantity(spec.ResourceBudget.CPU, budgetPath.Child("cpu"))...)
+		allErrs = append(allErrs, validateQuantity(spec.ResourceBudget.Memory, budgetPath.Child("memory"))...)
+	}
+
+	if spec.Topology != nil && len(spec.Topology.Zone) > 0 && len(spec.Topology.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topology", "region"), "region needs to be set if the zone is set"))
+	}
+
+	if spec.Cordon != nil {
+		cordonPath := fldPath.Child("cordon")
+		if len(spec.Cordon.Reason) == 0 {
+			allErrs = append(allErrs, field.Required(cordonPath.Child("reason"), "reason may not be empty"))
+		}
+		if spec.Cordon.Drain != nil && spec.Cordon.Drain.MaxConcurrentMigrations != nil && *spec.Cordon.Drain.MaxConcurrentMigrations < 1 {
+			allErrs = append(allErrs, field.Invalid(cordonPath.Child("drain", "maxConcurrentMigrations"),
+				*spec.Cordon.Drain.MaxConcurrentMigrations, "maxConcurrentMigrations must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateQuantity(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(value) == 0 {
+		return allErrs
+	}
+
+	if _, err := resource.ParseQuantity(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+	}
+
 	return allErrs
 }
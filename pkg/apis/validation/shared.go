@@ -5,8 +5,11 @@
 package validation
 
 import (
+	"fmt"
 	"slices"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
@@ -67,6 +70,44 @@ func ValidateDataPlane(dataPlane *v1alpha1.DataPlane, fldPath *field.Path) field
 	return allErrs
 }
 
+func ValidateMaintenanceTimeWindow(timeWindow *v1alpha1.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := time.Parse(v1alpha1.MaintenanceTimeWindowLayout, timeWindow.Begin); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin"), timeWindow.Begin, "must have the format HHMMSS+ZZZZ, e.g. 220000+0100"))
+	}
+
+	if _, err := time.Parse(v1alpha1.MaintenanceTimeWindowLayout, timeWindow.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), timeWindow.End, "must have the format HHMMSS+ZZZZ, e.g. 230000+0100"))
+	}
+
+	return allErrs
+}
+
+func ValidateHibernation(hibernation *v1alpha1.Hibernation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, schedule := range hibernation.Schedules {
+		schedulePath := fldPath.Child("schedules").Index(i)
+
+		if _, err := cron.ParseStandard(schedule.Start); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("start"), schedule.Start, fmt.Sprintf("invalid cron expression: %s", err.Error())))
+		}
+
+		if _, err := cron.ParseStandard(schedule.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("end"), schedule.End, fmt.Sprintf("invalid cron expression: %s", err.Error())))
+		}
+
+		if schedule.Location != nil {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("location"), *schedule.Location, "unknown time zone"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 var supportedDeployers = []string{"helm", "manifest", "container", "mock"}
 
 func ValidateLandscaperConfiguration(landscaperConfiguration *v1alpha1.LandscaperConfiguration, fldPath *field.Path) field.ErrorList {
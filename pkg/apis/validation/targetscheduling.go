@@ -5,9 +5,15 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/expressions"
 )
 
 // ValidateTargetScheduling validates a TargetScheduling
@@ -51,6 +57,35 @@ func validateSchedulingRule(rule *v1alpha1.SchedulingRule, fldPath *field.Path)
 		allErrs = append(allErrs, validateSchedulingSelector(&rule.Selector[i], selectorPath.Index(i))...)
 	}
 
+	if rule.Spread != nil {
+		allErrs = append(allErrs, validateSpreadPolicy(rule.Spread, fldPath.Child("spread"))...)
+	}
+
+	return allErrs
+}
+
+func validateSpreadPolicy(spread *v1alpha1.SpreadPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedModes := []string{string(v1alpha1.SpreadModeSoft), string(v1alpha1.SpreadModeHard)}
+	if len(spread.Mode) > 0 && !slices.Contains(supportedModes, string(spread.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), spread.Mode, supportedModes))
+	}
+
+	if len(spread.LabelName) > 0 {
+		for _, msg := range validation.IsQualifiedName(spread.LabelName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labelName"), spread.LabelName, msg))
+		}
+	}
+
+	if spread.MaxSkew != nil {
+		if spread.Mode != v1alpha1.SpreadModeHard {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSkew"), "maxSkew is only supported by the Hard mode"))
+		} else if *spread.MaxSkew < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSkew"), *spread.MaxSkew, "maxSkew must be an integer >= 1"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -69,6 +104,12 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 	if selector.MatchLabel != nil {
 		count++
 	}
+	if selector.MatchPurpose != nil {
+		count++
+	}
+	if len(selector.MatchExpression) > 0 {
+		count++
+	}
 	if selector.Or != nil {
 		count++
 	}
@@ -85,16 +126,26 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 
 	// check term
 	if selector.MatchTenant != nil {
-		if len(selector.MatchTenant.ID) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchTenant").Child("id"), "tenant id needs to be set"))
-		}
+		tenantPath := fldPath.Child("matchTenant")
+		allErrs = append(allErrs, validateSelectorOperator(selector.MatchTenant.Operator, selector.MatchTenant.ID, selector.MatchTenant.Values,
+			valueOperators, tenantPath, tenantPath.Child("id"), "tenant id")...)
 
 	} else if selector.MatchLabel != nil {
+		labelPath := fldPath.Child("matchLabel")
 		if len(selector.MatchLabel.Name) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchLabel").Child("name"), "label name needs to be set"))
+			allErrs = append(allErrs, field.Required(labelPath.Child("name"), "label name needs to be set"))
 		}
-		if len(selector.MatchLabel.Value) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchLabel").Child("value"), "label value needs to be set"))
+		allErrs = append(allErrs, validateSelectorOperator(selector.MatchLabel.Operator, selector.MatchLabel.Value, selector.MatchLabel.Values,
+			labelOperators, labelPath, labelPath.Child("value"), "label value")...)
+
+	} else if selector.MatchPurpose != nil {
+		purposePath := fldPath.Child("matchPurpose")
+		allErrs = append(allErrs, validateSelectorOperator(selector.MatchPurpose.Operator, selector.MatchPurpose.Value, selector.MatchPurpose.Values,
+			valueOperators, purposePath, purposePath.Child("value"), "purpose")...)
+
+	} else if len(selector.MatchExpression) > 0 {
+		if _, err := expressions.CompileSelectorExpression(selector.MatchExpression); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("matchExpression"), selector.MatchExpression, err.Error()))
 		}
 
 	} else if selector.And != nil {
@@ -113,3 +164,70 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 
 	return allErrs
 }
+
+var (
+	// valueOperators are the operators supported by tenant and purpose selectors
+	valueOperators = []v1alpha1.SelectorOperator{
+		v1alpha1.SelectorOpIn, v1alpha1.SelectorOpNotIn, v1alpha1.SelectorOpPrefix, v1alpha1.SelectorOpRegex,
+	}
+	// labelOperators are the operators supported by label selectors
+	labelOperators = append(slices.Clone(valueOperators), v1alpha1.SelectorOpExists, v1alpha1.SelectorOpDoesNotExist)
+)
+
+// validateSelectorOperator validates the operator and the values of a tenant, label or purpose selector.
+// Without operator, the exact value is required. With an operator, the exact value must not be set,
+// and the values are required, except for the operators Exists and DoesNotExist which don't allow values.
+func validateSelectorOperator(
+	operator v1alpha1.SelectorOperator,
+	exactValue string,
+	values []string,
+	supportedOperators []v1alpha1.SelectorOperator,
+	fldPath, exactValuePath *field.Path,
+	description string,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+	valuesPath := fldPath.Child("values")
+
+	if len(operator) == 0 {
+		if len(exactValue) == 0 {
+			allErrs = append(allErrs, field.Required(exactValuePath, fmt.Sprintf("%s needs to be set", description)))
+		}
+		if len(values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(valuesPath, "values require an operator"))
+		}
+		return allErrs
+	}
+
+	if !slices.Contains(supportedOperators, operator) {
+		supported := make([]string, len(supportedOperators))
+		for i := range supportedOperators {
+			supported[i] = string(supportedOperators[i])
+		}
+		return append(allErrs, field.NotSupported(fldPath.Child("operator"), operator, supported))
+	}
+
+	if len(exactValue) > 0 {
+		allErrs = append(allErrs, field.Forbidden(exactValuePath, fmt.Sprintf("%s must not be set together with an operator, use values instead", description)))
+	}
+
+	if operator == v1alpha1.SelectorOpExists || operator == v1alpha1.SelectorOpDoesNotExist {
+		if len(values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(valuesPath, fmt.Sprintf("values are not allowed with operator %s", operator)))
+		}
+		return allErrs
+	}
+
+	if len(values) == 0 {
+		allErrs = append(allErrs, field.Required(valuesPath, fmt.Sprintf("values are required with operator %s", operator)))
+	}
+
+	if operator == v1alpha1.SelectorOpRegex {
+		for i, expr := range values {
+			if _, err := regexp.Compile(expr); err != nil {
+				allErrs = append(allErrs, field.Invalid(valuesPath.Index(i), expr, fmt.Sprintf("invalid regular expression: %s", err.Error())))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// This file implements the rules for supported landscaper instance versions described in docs/proposals/multi-version.md.
+
+// SupportedVersions is the list of landscaper instance versions supported by a landscaper service landscape,
+// sorted in ascending order.
+type SupportedVersions []*semver.Version
+
+// ParseVersion parses a landscaper instance version.
+func ParseVersion(version string) (*semver.Version, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	return v, nil
+}
+
+// ParseSupportedVersions parses the given versions into a sorted list of supported versions.
+// For every combination of major and minor version at most one version may be given.
+func ParseSupportedVersions(versions []string) (SupportedVersions, error) {
+	supported := make(SupportedVersions, 0, len(versions))
+	for _, version := range versions {
+		v, err := ParseVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		if other := supported.SameMinorVersion(v); other != nil {
+			return nil, fmt.Errorf("versions %q and %q have the same major and minor version", other.Original(), version)
+		}
+		supported = append(supported, v)
+	}
+	sort.Sort(semver.Collection(supported))
+	return supported, nil
+}
+
+// Latest returns the highest supported version or nil if no version is supported.
+func (s SupportedVersions) Latest() *semver.Version {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[len(s)-1]
+}
+
+// Contains tests whether the given version is supported.
+func (s SupportedVersions) Contains(v *semver.Version) bool {
+	for _, supported := range s {
+		if supported.Equal(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// SameMinorVersion returns the supported version with the same major and minor version as the given version.
+// Nil is returned when the minor version is not supported.
+func (s SupportedVersions) SameMinorVersion(v *semver.Version) *semver.Version {
+	for _, supported := range s {
+		if isSameMinor(supported, v) {
+			return supported
+		}
+	}
+	return nil
+}
+
+// NextMinorVersion returns the supported version with the next higher minor version of the same major version.
+// If no such version exists, the lowest supported minor version of the next major version is returned.
+// Nil is returned when there is no higher supported minor version.
+func (s SupportedVersions) NextMinorVersion(v *semver.Version) *semver.Version {
+	for _, supported := range s {
+		if supported.GreaterThan(v) && !isSameMinor(supported, v) {
+			return supported
+		}
+	}
+	return nil
+}
+
+// IsDeprecated tests whether the given version is deprecated.
+// A version is deprecated when there is a supported version with a higher minor or major version.
+func (s SupportedVersions) IsDeprecated(v *semver.Version) bool {
+	return s.NextMinorVersion(v) != nil
+}
+
+// UpgradeVersions returns the supported versions to which an instance running the given version is allowed to be upgraded.
+// These are the supported patch version of its minor version and the next supported minor version.
+func (s SupportedVersions) UpgradeVersions(current *semver.Version) SupportedVersions {
+	result := make(SupportedVersions, 0, 2)
+	if patch := s.SameMinorVersion(current); patch != nil && patch.GreaterThan(current) {
+		result = append(result, patch)
+	}
+	if next := s.NextMinorVersion(current); next != nil {
+		result = append(result, next)
+	}
+	return result
+}
+
+// IsAllowedUpgrade tests whether an instance running the current version is allowed to be upgraded to the target version.
+func (s SupportedVersions) IsAllowedUpgrade(current, target *semver.Version) bool {
+	return target.Equal(current) || s.UpgradeVersions(current).Contains(target)
+}
+
+// Resolve returns the supported version which is installed for an instance that requests the given version.
+// This is the supported patch version of the requested minor version.
+// If the requested minor version is no longer supported, the instance is upgraded to the next supported minor version.
+func (s SupportedVersions) Resolve(v *semver.Version) (*semver.Version, error) {
+	if supported := s.SameMinorVersion(v); supported != nil && !supported.LessThan(v) {
+		return supported, nil
+	}
+	if next := s.NextMinorVersion(v); next != nil {
+		return next, nil
+	}
+	return nil, fmt.Errorf("version %q is not supported and there is no supported version to upgrade to", v.Original())
+}
+
+// Strings returns the original string representation of the supported versions.
+func (s SupportedVersions) Strings() []string {
+	result := make([]string, 0, len(s))
+	for _, v := range s {
+		result = append(result, v.Original())
+	}
+	return result
+}
+
+func isSameMinor(a, b *semver.Version) bool {
+	return a.Major() == b.Major() && a.Minor() == b.Minor()
+}
@@ -7,9 +7,12 @@ package avmonitorregistration
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -27,11 +30,11 @@ type Controller struct {
 	log logging.Logger
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *v1alpha1.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *v1alpha1.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
-	op := operation.NewOperation(c, scheme, config)
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
@@ -95,7 +98,7 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 
 	logger.Debug("creating/updating spec", lc.KeyResource, client.ObjectKeyFromObject(availabilityCollection).String())
-	_, err := kubernetes.CreateOrUpdate(ctx, c.Client(), availabilityCollection, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), availabilityCollection, func() error {
 		availabilityCollection.Spec = lssv1alpha1.AvailabilityCollectionSpec{
 			InstanceRefs: instanceRefsToMonitor,
 		}
@@ -106,6 +109,11 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
+	if result != controllerutil.OperationResultNone {
+		c.EventRecorder().Eventf(availabilityCollection, corev1.EventTypeNormal, operation.EventReasonMonitoringUpdated,
+			"Monitoring %d instances", len(instanceRefsToMonitor))
+	}
+
 	logger.Debug("reconcile completed successfully")
 	return reconcile.Result{}, nil
 }
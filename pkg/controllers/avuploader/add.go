@@ -20,12 +20,12 @@ import (
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("AVUploader", "AvailabilityCollection")
 
-	if config.AvailabilityMonitoring.AvailabilityServiceConfiguration == nil {
-		log.Info("AvailabilityServiceConfiguration missing, not starting AVUploader")
+	if config.AvailabilityMonitoring.AvailabilityServiceConfiguration == nil && len(config.AvailabilityMonitoring.Sinks) == 0 {
+		log.Info("AvailabilityServiceConfiguration and sinks missing, not starting AVUploader")
 		return nil
 	}
 
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("av-collection-controller"), config)
 	if err != nil {
 		return err
 	}
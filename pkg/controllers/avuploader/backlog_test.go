@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	avuploader "github.com/gardener/landscaper-service/pkg/controllers/avuploader"
+	"github.com/gardener/landscaper-service/pkg/operation"
+)
+
+// testBacklogSink records the reported snapshots and fails as long as failures are left.
+type testBacklogSink struct {
+	testSink
+	failures  int
+	calls     int
+	snapshots []lssv1alpha1.AvailabilitySnapshot
+}
+
+func (s *testBacklogSink) Snapshot(availabilityCollection *lssv1alpha1.AvailabilityCollection) lssv1alpha1.AvailabilitySnapshot {
+	return avuploader.NewSnapshot(availabilityCollection)
+}
+
+func (s *testBacklogSink) ReportSnapshot(_ context.Context, snapshot lssv1alpha1.AvailabilitySnapshot) error {
+	s.calls++
+	if s.failures > 0 {
+		s.failures--
+		return fmt.Errorf("unavailable")
+	}
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+var _ = Describe("Availability Backlog", func() {
+	var (
+		ctx                    context.Context
+		start                  time.Time
+		availabilityCollection *lssv1alpha1.AvailabilityCollection
+		recorder               *record.FakeRecorder
+		retryConfig            config.UploadRetryConfiguration
+	)
+
+	newController := func(sinks ...avuploader.Sink) *avuploader.Controller {
+		cfg := &config.LandscaperServiceConfiguration{}
+		cfg.AvailabilityMonitoring.UploadRetry = retryConfig
+		op := operation.NewOperation(nil, nil, cfg).WithEventRecorder(recorder)
+		return avuploader.NewTestActuator(*op, logging.Discard(), sinks...)
+	}
+
+	// run simulates a health watcher run and the following reconcile of the AVUploader.
+	run := func(ctrl *avuploader.Controller, minute int) {
+		availabilityCollection.Status.LastRun = metav1.NewTime(start.Add(time.Duration(minute) * time.Minute))
+		availabilityCollection.Status.Sinks = avuploader.ExportReportToSinks(ctrl, ctx, availabilityCollection)
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		recorder = record.NewFakeRecorder(100)
+		retryConfig = config.UploadRetryConfiguration{Attempts: 1}
+		availabilityCollection = &lssv1alpha1.AvailabilityCollection{
+			Status: lssv1alpha1.AvailabilityCollectionStatus{
+				Instances: []lssv1alpha1.AvailabilityInstance{
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "instance1", Namespace: "instance1-namespace"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusFailed),
+						FailedReason:    "timeout",
+					},
+				},
+				Self: lssv1alpha1.AvailabilityInstance{
+					ObjectReference: lssv1alpha1.ObjectReference{Name: "self", Namespace: "landscaper"},
+					Status:          string(lsv1alpha1.LsHealthCheckStatusOk),
+				},
+			},
+		}
+	})
+
+	It("should report the runs which could not be reported in order", func() {
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 2}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		run(ctrl, 2)
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Sinks[0].LastReported.IsZero()).To(BeTrue())
+		Expect(recorder.Events).To(HaveLen(2))
+
+		run(ctrl, 3)
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(BeEmpty())
+		Expect(availabilityCollection.Status.Sinks[0].LastReported.Time).To(Equal(start.Add(3 * time.Minute)))
+		Expect(sink.snapshots).To(HaveLen(3))
+		for i, snapshot := range sink.snapshots {
+			Expect(snapshot.Timestamp.Time).To(Equal(start.Add(time.Duration(i+1) * time.Minute)))
+			Expect(snapshot.MonitoredInstances).To(Equal(2))
+			Expect(snapshot.FailedInstances).To(HaveLen(1))
+		}
+	})
+
+	It("should not report a run twice", func() {
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		run(ctrl, 1)
+		Expect(sink.calls).To(Equal(1))
+	})
+
+	It("should drop the oldest runs if the backlog is full", func() {
+		retryConfig.MaxBacklog = 2
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 3}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		run(ctrl, 2)
+		run(ctrl, 3)
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Sinks[0].Backlog[0].Timestamp.Time).To(Equal(start.Add(2 * time.Minute)))
+
+		run(ctrl, 4)
+		Expect(sink.snapshots).To(HaveLen(2))
+		Expect(sink.snapshots[0].Timestamp.Time).To(Equal(start.Add(3 * time.Minute)))
+		Expect(sink.snapshots[1].Timestamp.Time).To(Equal(start.Add(4 * time.Minute)))
+	})
+
+	It("should drop the oldest runs if the backlog exceeds its size", func() {
+		// every snapshot has a size of about a third of the size budget
+		availabilityCollection.Status.Instances[0].FailedReason = strings.Repeat("x", lssv1alpha1.AvailabilityBacklogMaxSize/3)
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 4}
+		ctrl := newController(sink)
+
+		for minute := 1; minute <= 4; minute++ {
+			run(ctrl, minute)
+		}
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Sinks[0].Backlog[0].Timestamp.Time).To(Equal(start.Add(3 * time.Minute)))
+	})
+
+	It("should keep the last run if it exceeds the size of the backlog", func() {
+		availabilityCollection.Status.Instances[0].FailedReason = strings.Repeat("x", lssv1alpha1.AvailabilityBacklogMaxSize)
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 2}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		run(ctrl, 2)
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(HaveLen(1))
+		Expect(availabilityCollection.Status.Sinks[0].Backlog[0].Timestamp.Time).To(Equal(start.Add(2 * time.Minute)))
+	})
+
+	It("should retry a failed report", func() {
+		retryConfig.Attempts = 3
+		retryConfig.InitialBackoff.Duration = time.Millisecond
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 2}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		Expect(sink.calls).To(Equal(3))
+		Expect(sink.snapshots).To(HaveLen(1))
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not call a sink after too many consecutive failures", func() {
+		retryConfig.FailureThreshold = 2
+		retryConfig.OpenDuration.Duration = time.Hour
+		sink := &testBacklogSink{testSink: testSink{name: "avs"}, failures: 10}
+		ctrl := newController(sink)
+
+		run(ctrl, 1)
+		run(ctrl, 2)
+		Expect(sink.calls).To(Equal(2))
+
+		run(ctrl, 3)
+		Expect(sink.calls).To(Equal(2))
+		Expect(availabilityCollection.Status.Sinks[0].Backlog).To(HaveLen(3))
+		Expect(recorder.Events).To(HaveLen(2))
+	})
+
+	It("should construct the avs request of a snapshot", func() {
+		availabilityCollection.Status.LastRun = metav1.NewTime(start)
+		request := avuploader.ExportConstructAvsRequest(*availabilityCollection)
+		Expect(request.Timestamp).To(Equal(start.Unix()))
+		Expect(request.Status).To(Equal(avuploader.AVS_STATUS_DOWN))
+		Expect(request.OutageReason).To(Equal("1/2 monitored landscaper down"))
+		Expect(request.Instances).To(HaveLen(1))
+		Expect(request.Instances[0].InstanceId).To(Equal("instance1"))
+	})
+})
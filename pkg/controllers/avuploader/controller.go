@@ -8,42 +8,57 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/operation"
 )
 
 type Controller struct {
 	operation.Operation
-	log logging.Logger
+	log      logging.Logger
+	sinks    []Sink
+	breakers map[string]*circuitBreaker
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
-	ctrl := &Controller{
-		log: logger,
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	sinks, err := NewSinks(&config.AvailabilityMonitoring)
+	if err != nil {
+		return nil, err
 	}
-	op := operation.NewOperation(c, scheme, config)
-	ctrl.Operation = *op
-	return ctrl, nil
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
+	return newController(*op, logger, sinks), nil
 }
 
 // NewTestActuator creates a new controller for testing purposes.
-func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+func NewTestActuator(op operation.Operation, logger logging.Logger, sinks ...Sink) *Controller {
+	return newController(op, logger, sinks)
+}
+
+// newController creates a new controller, with a circuit breaker for every sink.
+func newController(op operation.Operation, logger logging.Logger, sinks []Sink) *Controller {
 	ctrl := &Controller{
 		Operation: op,
 		log:       logger,
+		sinks:     sinks,
+		breakers:  map[string]*circuitBreaker{},
+	}
+	for _, sink := range sinks {
+		ctrl.breakers[sink.Name()] = newCircuitBreaker(op.Config().AvailabilityMonitoring.UploadRetry)
 	}
 	return ctrl
 }
@@ -51,11 +66,9 @@ func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
 
-	logger.Debug("check if av service is configured")
-	if c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration == nil ||
-		c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.Url == "" ||
-		c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.ApiKey == "" {
-		logger.Info("av service not configured")
+	logger.Debug("check if availability sinks are configured")
+	if len(c.sinks) == 0 {
+		logger.Info("availability sinks not configured")
 		return reconcile.Result{}, nil
 	}
 
@@ -74,18 +87,17 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	request := constructAvsRequest(*availabilityCollection)
-
-	logger.Debug("perform avs upload")
-	err := doAvsRequest(request, c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.Url, c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.ApiKey,
-		c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.Timeout)
-	if err != nil {
-		logger.Error(err, "avs request failed")
-		return reconcile.Result{}, err
+	sinkStatus := c.reportToSinks(ctx, availabilityCollection)
+	reportedToAll := true
+	for _, status := range sinkStatus {
+		if !status.LastReported.Equal(&availabilityCollection.Status.LastRun) {
+			reportedToAll = false
+		}
+	}
+	availabilityCollection.Status.Sinks = sinkStatus
+	if reportedToAll {
+		availabilityCollection.Status.LastReported = availabilityCollection.Status.LastRun
 	}
-	logger.Debug("avs upload done")
-
-	availabilityCollection.Status.LastReported = availabilityCollection.Status.LastRun
 
 	//write to status
 	logger.Debug("updating status")
@@ -94,32 +106,158 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, fmt.Errorf("unable to update availability status: %w", err)
 	}
 
+	if !reportedToAll {
+		return reconcile.Result{}, fmt.Errorf("availability could not be reported to all sinks")
+	}
+
 	logger.Debug("reconcile completed successfully")
 	return reconcile.Result{}, nil
+}
+
+// reportToSinks reports the availability to every sink which has not yet reported the last health watcher run.
+// A failing sink doesn't prevent the other sinks from reporting. The returned status contains the last reported
+// time and the backlog of every configured sink, the status of sinks which are no longer configured is dropped.
+func (c *Controller) reportToSinks(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) []lssv1alpha1.AvailabilitySinkStatus {
+	oldStatus := map[string]lssv1alpha1.AvailabilitySinkStatus{}
+	for _, status := range availabilityCollection.Status.Sinks {
+		oldStatus[status.Name] = status
+	}
+	if _, ok := oldStatus[LegacyAvsSinkName]; !ok {
+		// the avs sink has been reported with the overall last reported time before the sinks had their own status
+		oldStatus[LegacyAvsSinkName] = lssv1alpha1.AvailabilitySinkStatus{LastReported: availabilityCollection.Status.LastReported}
+	}
+
+	sinkStatus := make([]lssv1alpha1.AvailabilitySinkStatus, 0, len(c.sinks))
+	for _, sink := range c.sinks {
+		status := oldStatus[sink.Name()]
+		status.Name = sink.Name()
+
+		if backlogSink, ok := sink.(BacklogSink); ok {
+			c.reportBacklog(ctx, backlogSink, availabilityCollection, &status)
+		} else if !status.LastReported.Equal(&availabilityCollection.Status.LastRun) {
+			err := c.report(ctx, sink, func(ctx context.Context) error {
+				return sink.Report(ctx, availabilityCollection)
+			})
+			if err != nil {
+				c.recordReportFailure(ctx, availabilityCollection, sink, err)
+			} else {
+				status.LastReported = availabilityCollection.Status.LastRun
+			}
+			status.Backlog = nil
+		}
+
+		metrics.SetAvailabilitySinkBacklog(sink.Name(), len(status.Backlog))
+		sinkStatus = append(sinkStatus, status)
+	}
+	return sinkStatus
+}
+
+// reportBacklog adds the last health watcher run to the backlog of a sink and reports the backlog in order,
+// until a report fails. Runs which are not newer than the last reported run are dropped, because they would be rejected.
+func (c *Controller) reportBacklog(ctx context.Context, sink BacklogSink, availabilityCollection *lssv1alpha1.AvailabilityCollection,
+	status *lssv1alpha1.AvailabilitySinkStatus) {
+
+	lastRun := availabilityCollection.Status.LastRun
+	lastQueued := status.LastReported
+	if len(status.Backlog) > 0 {
+		lastQueued = status.Backlog[len(status.Backlog)-1].Timestamp
+	}
+	if lastRun.After(lastQueued.Time) {
+		status.Backlog = append(status.Backlog, sink.Snapshot(availabilityCollection))
+	}
 
+	maxBacklog := c.Config().AvailabilityMonitoring.UploadRetry.MaxBacklog
+	if maxBacklog > 0 && len(status.Backlog) > maxBacklog {
+		status.Backlog = status.Backlog[len(status.Backlog)-maxBacklog:]
+	}
+	// the size budget of the backlogs is shared by all sinks
+	status.Backlog = limitBacklogSize(status.Backlog, lssv1alpha1.AvailabilityBacklogMaxSize/len(c.sinks))
+
+	for len(status.Backlog) > 0 {
+		snapshot := status.Backlog[0]
+		if snapshot.Timestamp.After(status.LastReported.Time) {
+			err := c.report(ctx, sink, func(ctx context.Context) error {
+				return sink.ReportSnapshot(ctx, snapshot)
+			})
+			if err != nil {
+				c.recordReportFailure(ctx, availabilityCollection, sink, err)
+				return
+			}
+			status.LastReported = snapshot.Timestamp
+		}
+		status.Backlog = status.Backlog[1:]
+	}
+	status.Backlog = nil
+}
+
+// limitBacklogSize drops the oldest runs of a backlog until its serialized size doesn't exceed the given maximum size in bytes.
+// The last run is always kept, so that it can still be reported.
+func limitBacklogSize(backlog []lssv1alpha1.AvailabilitySnapshot, maxSize int) []lssv1alpha1.AvailabilitySnapshot {
+	sizes := make([]int, len(backlog))
+	size := 0
+	for i := range backlog {
+		raw, err := json.Marshal(backlog[i])
+		if err != nil {
+			continue
+		}
+		// the snapshot and its separator
+		sizes[i] = len(raw) + 1
+		size += sizes[i]
+	}
+
+	dropped := 0
+	for dropped < len(backlog)-1 && size > maxSize {
+		size -= sizes[dropped]
+		dropped++
+	}
+	return backlog[dropped:]
+}
+
+// report calls the report function of a sink with retries, unless the circuit breaker of the sink is open.
+func (c *Controller) report(ctx context.Context, sink Sink, report func(ctx context.Context) error) error {
+	breaker := c.breakers[sink.Name()]
+	if !breaker.allow(time.Now()) {
+		return ErrCircuitOpen
+	}
+	err := reportWithRetry(ctx, c.Config().AvailabilityMonitoring.UploadRetry, report)
+	breaker.record(err, time.Now())
+	return err
+}
+
+// recordReportFailure logs a failed report and records an event, except for a sink which is not called because its circuit breaker is open.
+func (c *Controller) recordReportFailure(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection, sink Sink, err error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if errors.Is(err, ErrCircuitOpen) {
+		logger.Debug("availability report skipped", "sink", sink.Name(), "reason", err.Error())
+		return
+	}
+	logger.Error(err, "availability report failed", "sink", sink.Name())
+	c.EventRecorder().Eventf(availabilityCollection, corev1.EventTypeWarning, operation.EventReasonAvailabilityUploadFailed,
+		"Availability could not be reported to sink %s: %s", sink.Name(), err.Error())
 }
 
 func constructAvsRequest(availabilityCollection lssv1alpha1.AvailabilityCollection) AvsRequest {
+	return constructAvsRequestFromSnapshot(NewSnapshot(&availabilityCollection))
+}
+
+// constructAvsRequestFromSnapshot constructs the avs request for the health watcher run of an availability snapshot.
+func constructAvsRequestFromSnapshot(snapshot lssv1alpha1.AvailabilitySnapshot) AvsRequest {
 	//Fill failedInstances with all failed. A failed instance will create an instance outage. If this instance is not in the array anymore, instance outage is resolved
 	// Overall status is derived if len(failedInstances) > 0
 	failedInstances := []AvsInstance{}
-	for _, instanceStatus := range availabilityCollection.Status.Instances {
-		if instanceStatus.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
-			avsInstance := AvsInstance{
-				InstanceId:   instanceStatus.Name,
-				Name:         instanceStatus.Name,
-				Status:       AVS_STATUS_DOWN,
-				OutageReason: instanceStatus.FailedReason,
-			}
-			failedInstances = append(failedInstances, avsInstance)
-		}
-	}
-	if availabilityCollection.Status.Self.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+	for _, failedInstance := range snapshot.FailedInstances {
 		avsInstance := AvsInstance{
-			InstanceId:   "Self",
-			Name:         "Self",
+			InstanceId:   failedInstance.Name,
+			Name:         failedInstance.Name,
 			Status:       AVS_STATUS_DOWN,
-			OutageReason: availabilityCollection.Status.Self.FailedReason,
+			OutageReason: failedInstance.FailedReason,
+		}
+		if failedInstance.Self {
+			avsInstance.InstanceId = "Self"
+			avsInstance.Name = "Self"
+		} else if failedInstance.Identity != nil {
+			avsInstance.InstanceId = failedInstance.Identity.InstanceId
+			avsInstance.Name = avsInstanceName(failedInstance)
 		}
 		failedInstances = append(failedInstances, avsInstance)
 	}
@@ -128,13 +266,11 @@ func constructAvsRequest(availabilityCollection lssv1alpha1.AvailabilityCollecti
 	outageReason := ""
 	if len(failedInstances) > 0 {
 		status = AVS_STATUS_DOWN
-		//include self landscaper (--> +1 )
-		totalNumberOfMonitoredLandscapers := len(availabilityCollection.Status.Instances) + 1
-		outageReason = fmt.Sprintf("%d/%d monitored landscaper down", len(failedInstances), totalNumberOfMonitoredLandscapers)
+		outageReason = fmt.Sprintf("%d/%d monitored landscaper down", len(failedInstances), snapshot.MonitoredInstances)
 	}
 
 	request := AvsRequest{
-		Timestamp:          availabilityCollection.Status.LastRun.Unix(),
+		Timestamp:          snapshot.Timestamp.Unix(),
 		ResponseTime:       0,
 		ResponseStatusCode: 200,
 		Instances:          failedInstances,
@@ -144,7 +280,21 @@ func constructAvsRequest(availabilityCollection lssv1alpha1.AvailabilityCollecti
 	return request
 }
 
-func doAvsRequest(request AvsRequest, url string, apiKey string, timeoutConfig string) error {
+// avsInstanceName returns the name of an instance in the AVS dashboard, which consists of the tenant,
+// the owning LandscaperDeployment and its purpose, e.g. "tenant1/my-deployment (production)".
+func avsInstanceName(failedInstance lssv1alpha1.FailedAvailabilityInstance) string {
+	deployment := failedInstance.Identity.LandscaperDeployment
+	if deployment == "" {
+		deployment = failedInstance.Name
+	}
+	name := fmt.Sprintf("%s/%s", failedInstance.Identity.TenantId, deployment)
+	if failedInstance.Identity.Purpose != "" {
+		name = fmt.Sprintf("%s (%s)", name, failedInstance.Identity.Purpose)
+	}
+	return name
+}
+
+func doAvsRequest(ctx context.Context, request AvsRequest, url string, apiKey string, timeoutConfig string) error {
 	body, err := json.Marshal(request)
 	if err != nil {
 		return fmt.Errorf("avs upload post payload json marhsal failed: %w", err)
@@ -156,7 +306,7 @@ func doAvsRequest(request AvsRequest, url string, apiKey string, timeoutConfig s
 
 	client := &http.Client{}
 	client.Timeout = timeout
-	avsreq, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	avsreq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
 	if err != nil {
 		return fmt.Errorf("avs upload request build failed: %w", err)
 	}
@@ -4,3 +4,5 @@
 package avuploader
 
 var ExportConstructAvsRequest = constructAvsRequest
+
+var ExportReportToSinks = (*Controller).reportToSinks
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	avuploader "github.com/gardener/landscaper-service/pkg/controllers/avuploader"
+)
+
+var _ = Describe("Instance Identity", func() {
+	var availabilityCollection *lssv1alpha1.AvailabilityCollection
+
+	BeforeEach(func() {
+		availabilityCollection = &lssv1alpha1.AvailabilityCollection{
+			Status: lssv1alpha1.AvailabilityCollectionStatus{
+				LastRun: metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)),
+				Instances: []lssv1alpha1.AvailabilityInstance{
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "test-abcde", Namespace: "tenant1"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusFailed),
+						FailedReason:    "timeout",
+						Identity: &lssv1alpha1.InstanceIdentity{
+							TenantId:             "tenant1",
+							InstanceId:           "a1b2c3",
+							LandscaperDeployment: "test",
+							Purpose:              "production",
+						},
+					},
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "other-fghij", Namespace: "tenant1"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusOk),
+						Identity: &lssv1alpha1.InstanceIdentity{
+							TenantId:   "tenant1",
+							InstanceId: "d4e5f6",
+						},
+					},
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "test-klmno", Namespace: "tenant2"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusFailed),
+						FailedReason:    "lshealthcheck failed",
+						Identity: &lssv1alpha1.InstanceIdentity{
+							TenantId:   "tenant2",
+							InstanceId: "g7h8i9",
+						},
+					},
+				},
+				Self: lssv1alpha1.AvailabilityInstance{
+					ObjectReference: lssv1alpha1.ObjectReference{Name: "self", Namespace: "landscaper"},
+					Status:          string(lsv1alpha1.LsHealthCheckStatusFailed),
+					FailedReason:    "timeout",
+				},
+			},
+		}
+	})
+
+	It("should identify the instances in the avs request", func() {
+		request := avuploader.ExportConstructAvsRequest(*availabilityCollection)
+		Expect(request.Instances).To(HaveLen(3))
+		Expect(request.Instances[0].InstanceId).To(Equal("a1b2c3"))
+		Expect(request.Instances[0].Name).To(Equal("tenant1/test (production)"))
+		Expect(request.Instances[1].InstanceId).To(Equal("g7h8i9"))
+		Expect(request.Instances[1].Name).To(Equal("tenant2/test-klmno"))
+		Expect(request.Instances[2].InstanceId).To(Equal("Self"))
+	})
+
+	It("should restrict the snapshot to the instances of a tenant", func() {
+		snapshot := avuploader.NewTenantSnapshot(availabilityCollection, "tenant1")
+		Expect(snapshot.MonitoredInstances).To(Equal(2))
+		Expect(snapshot.FailedInstances).To(HaveLen(1))
+		Expect(snapshot.FailedInstances[0].Identity.InstanceId).To(Equal("a1b2c3"))
+
+		snapshot = avuploader.NewTenantSnapshot(availabilityCollection, "unknown")
+		Expect(snapshot.MonitoredInstances).To(Equal(0))
+		Expect(snapshot.FailedInstances).To(BeEmpty())
+	})
+
+	It("should upload only the instances of the tenant of an avs sink", func() {
+		var request avuploader.AvsRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			Expect(json.Unmarshal(body, &request)).To(Succeed())
+		}))
+		defer server.Close()
+
+		sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+			Sinks: []config.AvailabilitySinkConfiguration{{
+				Name: "avs-tenant2",
+				AVS:  &config.AvailabilityServiceConfiguration{Url: server.URL, ApiKey: "key", Timeout: "10s", TenantId: "tenant2"},
+			}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sinks[0].Report(logging.NewContextWithDiscard(context.Background()), availabilityCollection)).To(Succeed())
+
+		Expect(request.Status).To(Equal(avuploader.AVS_STATUS_DOWN))
+		Expect(request.OutageReason).To(Equal("1/1 monitored landscaper down"))
+		Expect(request.Instances).To(HaveLen(1))
+		Expect(request.Instances[0].InstanceId).To(Equal("g7h8i9"))
+	})
+
+	It("should identify the instances in the report", func() {
+		report := avuploader.NewReport(availabilityCollection)
+		Expect(report.Instances[0].TenantId).To(Equal("tenant1"))
+		Expect(report.Instances[0].InstanceId).To(Equal("a1b2c3"))
+		Expect(report.Instances[0].LandscaperDeployment).To(Equal("test"))
+		Expect(report.Instances[0].Purpose).To(Equal("production"))
+		Expect(report.Self.TenantId).To(BeEmpty())
+	})
+})
@@ -56,6 +56,25 @@ var _ = Describe("avs request construction", func() {
 		Expect(len(request.Instances)).To(Equal(0))
 	})
 
+	It("should not report a hibernated instance as outage", func() {
+		availabilityCollection := lssv1alpha1.AvailabilityCollection{
+			Status: lssv1alpha1.AvailabilityCollectionStatus{
+				Instances: []lssv1alpha1.AvailabilityInstance{
+					{
+						Status: string(lssv1alpha1.LsHealthCheckStatusHibernated),
+						ObjectReference: lssv1alpha1.ObjectReference{
+							Name:      "instance1",
+							Namespace: "instance1-namespace",
+						},
+					},
+				},
+			},
+		}
+		request := avuploader.ExportConstructAvsRequest(availabilityCollection)
+		Expect(request.Status).To(Equal(avuploader.AVS_STATUS_UP))
+		Expect(len(request.Instances)).To(Equal(0))
+	})
+
 	It("should construct a DOWN avs request", func() {
 		availabilityCollection := lssv1alpha1.AvailabilityCollection{
 			Status: lssv1alpha1.AvailabilityCollectionStatus{
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// ReportStatusUp is the status of a report in which all monitored landscapers are available.
+	ReportStatusUp = "Up"
+	// ReportStatusDown is the status of a report in which at least one monitored landscaper is unavailable.
+	ReportStatusDown = "Down"
+)
+
+// Report is the availability of all monitored landscapers of one health watcher run,
+// in the sink independent format which is sent by the webhook and file sinks.
+type Report struct {
+	// Timestamp is the time of the health watcher run.
+	Timestamp time.Time `json:"timestamp"`
+	// Status is the overall availability status, Up or Down.
+	Status string `json:"status"`
+	// OutageReason is the reason of the overall unavailability.
+	OutageReason string `json:"outageReason,omitempty"`
+	// Instances contains the availability of all monitored instances.
+	Instances []ReportInstance `json:"instances"`
+	// Self is the availability of the landscaper on the core cluster.
+	Self ReportInstance `json:"self"`
+}
+
+// ReportInstance is the availability of a single monitored landscaper.
+type ReportInstance struct {
+	// Name is the name of the instance.
+	Name string `json:"name"`
+	// Namespace is the namespace of the instance.
+	Namespace string `json:"namespace"`
+	// Status is the availability status of the instance.
+	Status string `json:"status"`
+	// FailedReason is the reason the instance is unavailable.
+	FailedReason string `json:"failedReason,omitempty"`
+	// FailureType tells whether the landscaper or the external data plane cluster is unavailable.
+	FailureType string `json:"failureType,omitempty"`
+	// TenantId is the unique identifier of the owning tenant.
+	TenantId string `json:"tenantId,omitempty"`
+	// InstanceId is the id of the instance.
+	InstanceId string `json:"instanceId,omitempty"`
+	// LandscaperDeployment is the name of the owning LandscaperDeployment.
+	LandscaperDeployment string `json:"landscaperDeployment,omitempty"`
+	// Purpose is the purpose of the owning LandscaperDeployment.
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// NewReport creates the report for the current status of an availability collection.
+func NewReport(availabilityCollection *lssv1alpha1.AvailabilityCollection) *Report {
+	report := &Report{
+		Timestamp: availabilityCollection.Status.LastRun.Time,
+		Status:    ReportStatusUp,
+		Instances: make([]ReportInstance, 0, len(availabilityCollection.Status.Instances)),
+		Self:      newReportInstance(availabilityCollection.Status.Self),
+	}
+
+	failed := 0
+	for _, instance := range availabilityCollection.Status.Instances {
+		report.Instances = append(report.Instances, newReportInstance(instance))
+		if instance.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+			failed++
+		}
+	}
+	if report.Self.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+		failed++
+	}
+
+	if failed > 0 {
+		report.Status = ReportStatusDown
+		//include self landscaper (--> +1 )
+		report.OutageReason = fmt.Sprintf("%d/%d monitored landscaper down", failed, len(report.Instances)+1)
+	}
+	return report
+}
+
+func newReportInstance(availabilityInstance lssv1alpha1.AvailabilityInstance) ReportInstance {
+	reportInstance := ReportInstance{
+		Name:         availabilityInstance.Name,
+		Namespace:    availabilityInstance.Namespace,
+		Status:       availabilityInstance.Status,
+		FailedReason: availabilityInstance.FailedReason,
+		FailureType:  availabilityInstance.FailureType,
+	}
+	if identity := availabilityInstance.Identity; identity != nil {
+		reportInstance.TenantId = identity.TenantId
+		reportInstance.InstanceId = identity.InstanceId
+		reportInstance.LandscaperDeployment = identity.LandscaperDeployment
+		reportInstance.Purpose = identity.Purpose
+	}
+	return reportInstance
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+)
+
+// ErrCircuitOpen is returned for a sink which is not called, because its last reports have failed too often.
+var ErrCircuitOpen = errors.New("sink is not called after too many consecutive failures")
+
+// circuitBreaker stops calling a sink for a while after too many consecutive failed reports.
+// After the open duration the sink is called again, a further failure opens the circuit again immediately.
+type circuitBreaker struct {
+	mutex            sync.Mutex
+	failureThreshold int
+	openDuration     time.Duration
+	failures         int
+	openUntil        time.Time
+}
+
+func newCircuitBreaker(retryConfig config.UploadRetryConfiguration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: retryConfig.FailureThreshold,
+		openDuration:     retryConfig.OpenDuration.Duration,
+	}
+}
+
+// allow returns whether the sink may be called.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.failureThreshold <= 0 || !now.Before(b.openUntil)
+}
+
+// record records the result of a report.
+func (b *circuitBreaker) record(err error, now time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err == nil {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failureThreshold > 0 && b.failures >= b.failureThreshold {
+		b.openUntil = now.Add(b.openDuration)
+	}
+}
+
+// reportWithRetry calls the report function until it succeeds or the configured number of attempts is reached.
+// The backoff between the attempts starts with the initial backoff and is doubled after every attempt.
+func reportWithRetry(ctx context.Context, retryConfig config.UploadRetryConfiguration, report func(ctx context.Context) error) error {
+	backoff := retryConfig.InitialBackoff.Duration
+	for attempt := 1; ; attempt++ {
+		err := report(ctx)
+		if err == nil || attempt >= retryConfig.Attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
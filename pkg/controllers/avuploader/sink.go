@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"context"
+	"fmt"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// LegacyAvsSinkName is the name of the sink which is created from the AvailabilityServiceConfiguration.
+const LegacyAvsSinkName = "avs"
+
+// Sink is a destination to which the availability of the monitored landscapers is reported.
+type Sink interface {
+	// Name returns the unique name of the sink.
+	Name() string
+	// Report reports the current status of the availability collection.
+	Report(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) error
+}
+
+// BacklogSink is a sink which reports every health watcher run in order, instead of only the last one.
+// The runs which could not be reported are kept in the backlog of the sink status and reported once the sink is available again.
+type BacklogSink interface {
+	Sink
+	// Snapshot creates the snapshot of the last health watcher run which is added to the backlog.
+	Snapshot(availabilityCollection *lssv1alpha1.AvailabilityCollection) lssv1alpha1.AvailabilitySnapshot
+	// ReportSnapshot reports the availability of a single health watcher run.
+	ReportSnapshot(ctx context.Context, snapshot lssv1alpha1.AvailabilitySnapshot) error
+}
+
+// NewSinks creates the sinks of the availability monitoring configuration.
+// The AvailabilityServiceConfiguration is added as AVS sink with the name "avs", if the url and the api key are set.
+func NewSinks(cfg *config.AvailabilityMonitoringConfiguration) ([]Sink, error) {
+	sinks := []Sink{}
+	names := map[string]bool{}
+
+	avsConfig := cfg.AvailabilityServiceConfiguration
+	if avsConfig != nil && avsConfig.Url != "" && avsConfig.ApiKey != "" {
+		sinks = append(sinks, newAvsSink(LegacyAvsSinkName, avsConfig))
+		names[LegacyAvsSinkName] = true
+	}
+
+	for _, sinkConfig := range cfg.Sinks {
+		if sinkConfig.Name == "" {
+			return nil, fmt.Errorf("availability sink without name")
+		}
+		if names[sinkConfig.Name] {
+			return nil, fmt.Errorf("duplicate availability sink name %q", sinkConfig.Name)
+		}
+		names[sinkConfig.Name] = true
+
+		sink, err := newSink(sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid availability sink %q: %w", sinkConfig.Name, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
+// newSink creates the sink of the single sink type which is set in the configuration.
+func newSink(sinkConfig config.AvailabilitySinkConfiguration) (Sink, error) {
+	var sinks []Sink
+
+	if sinkConfig.AVS != nil {
+		if sinkConfig.AVS.Url == "" || sinkConfig.AVS.ApiKey == "" {
+			return nil, fmt.Errorf("avs url and api key must be set")
+		}
+		sinks = append(sinks, newAvsSink(sinkConfig.Name, sinkConfig.AVS))
+	}
+	if sinkConfig.Webhook != nil {
+		sink, err := newWebhookSink(sinkConfig.Name, sinkConfig.Webhook)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if sinkConfig.Pushgateway != nil {
+		if sinkConfig.Pushgateway.Url == "" {
+			return nil, fmt.Errorf("pushgateway url must be set")
+		}
+		sinks = append(sinks, newPushgatewaySink(sinkConfig.Name, sinkConfig.Pushgateway))
+	}
+	if sinkConfig.File != nil {
+		if sinkConfig.File.Path == "" {
+			return nil, fmt.Errorf("file path must be set")
+		}
+		sinks = append(sinks, newFileSink(sinkConfig.Name, sinkConfig.File))
+	}
+
+	if len(sinks) != 1 {
+		return nil, fmt.Errorf("exactly one sink type must be set, found %d", len(sinks))
+	}
+	return sinks[0], nil
+}
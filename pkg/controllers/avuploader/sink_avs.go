@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"context"
+	"time"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+// avsSink uploads the availability to an AVS service.
+// AVS requires every upload to be newer than the previous one and doesn't fill gaps by itself,
+// therefore the runs which could not be uploaded are kept in the backlog and uploaded in order.
+type avsSink struct {
+	name   string
+	config *config.AvailabilityServiceConfiguration
+}
+
+func newAvsSink(name string, avsConfig *config.AvailabilityServiceConfiguration) *avsSink {
+	return &avsSink{
+		name:   name,
+		config: avsConfig,
+	}
+}
+
+// Name implements Sink.
+func (s *avsSink) Name() string {
+	return s.name
+}
+
+// Report implements Sink.
+func (s *avsSink) Report(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) error {
+	return s.ReportSnapshot(ctx, s.Snapshot(availabilityCollection))
+}
+
+// Snapshot implements BacklogSink.
+// The snapshot of a sink for a tenant only contains the instances of the tenant.
+func (s *avsSink) Snapshot(availabilityCollection *lssv1alpha1.AvailabilityCollection) lssv1alpha1.AvailabilitySnapshot {
+	if s.config.TenantId != "" {
+		return NewTenantSnapshot(availabilityCollection, s.config.TenantId)
+	}
+	return NewSnapshot(availabilityCollection)
+}
+
+// ReportSnapshot implements BacklogSink.
+func (s *avsSink) ReportSnapshot(ctx context.Context, snapshot lssv1alpha1.AvailabilitySnapshot) error {
+	request := constructAvsRequestFromSnapshot(snapshot)
+	start := time.Now()
+	err := doAvsRequest(ctx, request, s.config.Url, s.config.ApiKey, s.config.Timeout)
+	metrics.ObserveAvsUpload(start, err)
+	return err
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// fileSink appends the availability reports as json lines to a file.
+type fileSink struct {
+	name   string
+	config *config.FileSinkConfiguration
+	mutex  sync.Mutex
+}
+
+func newFileSink(name string, fileConfig *config.FileSinkConfiguration) *fileSink {
+	return &fileSink{
+		name:   name,
+		config: fileConfig,
+	}
+}
+
+// Name implements Sink.
+func (s *fileSink) Name() string {
+	return s.name
+}
+
+// Report implements Sink.
+func (s *fileSink) Report(_ context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) error {
+	line, err := json.Marshal(NewReport(availabilityCollection))
+	if err != nil {
+		return fmt.Errorf("file sink payload json marshal failed: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.OpenFile(s.config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open file %s: %w", s.config.Path, err)
+	}
+	if _, err := file.Write(line); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("unable to write to file %s: %w", s.config.Path, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+// pushgatewaySink pushes the availability as metrics to a Prometheus Pushgateway.
+// All metrics of the job are replaced with every push, so that instances which are no longer monitored disappear.
+type pushgatewaySink struct {
+	name   string
+	config *config.PushgatewaySinkConfiguration
+	client *http.Client
+}
+
+func newPushgatewaySink(name string, pushgatewayConfig *config.PushgatewaySinkConfiguration) *pushgatewaySink {
+	return &pushgatewaySink{
+		name:   name,
+		config: pushgatewayConfig,
+		client: &http.Client{Timeout: pushgatewayConfig.Timeout.Duration},
+	}
+}
+
+// Name implements Sink.
+func (s *pushgatewaySink) Name() string {
+	return s.name
+}
+
+// Report implements Sink.
+func (s *pushgatewaySink) Report(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) error {
+	report := NewReport(availabilityCollection)
+
+	up := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "availability",
+		Name:      "up",
+		Help:      "Overall availability of the monitored landscapers (1 = all available, 0 = at least one unavailable).",
+	})
+	instanceUp := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "availability",
+		Name:      "instance_up",
+		Help:      "Availability of a monitored landscaper (1 = available, 0 = unavailable).",
+	}, []string{"namespace", "name", "status", "tenant_id", "instance_id", "landscaper_deployment", "purpose"})
+	lastRun := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "availability",
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Time of the health watcher run of the reported availability.",
+	})
+
+	up.Set(boolToFloat(report.Status == ReportStatusUp))
+	lastRun.Set(float64(report.Timestamp.Unix()))
+	for _, instance := range append(report.Instances, report.Self) {
+		instanceUp.WithLabelValues(instance.Namespace, instance.Name, instance.Status,
+			instance.TenantId, instance.InstanceId, instance.LandscaperDeployment, instance.Purpose).
+			Set(boolToFloat(instance.Status != string(lsv1alpha1.LsHealthCheckStatusFailed)))
+	}
+
+	err := push.New(s.config.Url, s.config.Job).
+		Client(s.client).
+		Collector(up).
+		Collector(instanceUp).
+		Collector(lastRun).
+		PushContext(ctx)
+	if err != nil {
+		return fmt.Errorf("pushgateway push failed: %w", err)
+	}
+	return nil
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	avuploader "github.com/gardener/landscaper-service/pkg/controllers/avuploader"
+	"github.com/gardener/landscaper-service/pkg/operation"
+)
+
+// testSink records the reported availability collections and fails if an error is set.
+type testSink struct {
+	name     string
+	err      error
+	reported int
+}
+
+func (s *testSink) Name() string {
+	return s.name
+}
+
+func (s *testSink) Report(_ context.Context, _ *lssv1alpha1.AvailabilityCollection) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.reported++
+	return nil
+}
+
+var _ = Describe("Availability Sinks", func() {
+	var (
+		ctx                    context.Context
+		availabilityCollection *lssv1alpha1.AvailabilityCollection
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		availabilityCollection = &lssv1alpha1.AvailabilityCollection{
+			Status: lssv1alpha1.AvailabilityCollectionStatus{
+				LastRun: metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)),
+				Instances: []lssv1alpha1.AvailabilityInstance{
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "instance1", Namespace: "instance1-namespace"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusOk),
+					},
+					{
+						ObjectReference: lssv1alpha1.ObjectReference{Name: "instance2", Namespace: "instance2-namespace"},
+						Status:          string(lsv1alpha1.LsHealthCheckStatusFailed),
+						FailedReason:    "timeout",
+					},
+				},
+				Self: lssv1alpha1.AvailabilityInstance{
+					ObjectReference: lssv1alpha1.ObjectReference{Name: "self", Namespace: "landscaper"},
+					Status:          string(lsv1alpha1.LsHealthCheckStatusOk),
+				},
+			},
+		}
+	})
+
+	Context("NewSinks", func() {
+		It("should create the legacy avs sink and the configured sinks", func() {
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				AvailabilityServiceConfiguration: &config.AvailabilityServiceConfiguration{
+					Url:    "https://avs.example.com",
+					ApiKey: "key",
+				},
+				Sinks: []config.AvailabilitySinkConfiguration{
+					{Name: "file", File: &config.FileSinkConfiguration{Path: "/tmp/availability.jsonl"}},
+					{Name: "webhook", Webhook: &config.WebhookSinkConfiguration{Url: "https://webhook.example.com"}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sinks).To(HaveLen(3))
+			Expect(sinks[0].Name()).To(Equal(avuploader.LegacyAvsSinkName))
+			Expect(sinks[1].Name()).To(Equal("file"))
+			Expect(sinks[2].Name()).To(Equal("webhook"))
+		})
+
+		It("should not create the legacy avs sink without url and api key", func() {
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				AvailabilityServiceConfiguration: &config.AvailabilityServiceConfiguration{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sinks).To(BeEmpty())
+		})
+
+		It("should reject invalid sink configurations", func() {
+			for _, sinks := range [][]config.AvailabilitySinkConfiguration{
+				{{File: &config.FileSinkConfiguration{Path: "/tmp/a"}}},
+				{{Name: "a", File: &config.FileSinkConfiguration{Path: "/tmp/a"}}, {Name: "a", File: &config.FileSinkConfiguration{Path: "/tmp/b"}}},
+				{{Name: "a"}},
+				{{Name: "a", File: &config.FileSinkConfiguration{Path: "/tmp/a"}, Pushgateway: &config.PushgatewaySinkConfiguration{Url: "http://pgw"}}},
+				{{Name: "a", Webhook: &config.WebhookSinkConfiguration{Url: "http://webhook", Template: "{{ .Status"}}},
+				{{Name: "a", AVS: &config.AvailabilityServiceConfiguration{Url: "http://avs"}}},
+			} {
+				_, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{Sinks: sinks})
+				Expect(err).To(HaveOccurred(), fmt.Sprintf("%+v", sinks))
+			}
+		})
+	})
+
+	Context("Report", func() {
+		It("should construct the report of an availability collection", func() {
+			report := avuploader.NewReport(availabilityCollection)
+			Expect(report.Timestamp).To(Equal(availabilityCollection.Status.LastRun.Time))
+			Expect(report.Status).To(Equal(avuploader.ReportStatusDown))
+			Expect(report.OutageReason).To(Equal("1/3 monitored landscaper down"))
+			Expect(report.Instances).To(HaveLen(2))
+			Expect(report.Instances[1].FailedReason).To(Equal("timeout"))
+			Expect(report.Self.Name).To(Equal("self"))
+		})
+	})
+
+	Context("Webhook", func() {
+		It("should post the rendered template with the configured headers", func() {
+			var (
+				body   []byte
+				header http.Header
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header
+				body, _ = io.ReadAll(r.Body)
+			}))
+			defer server.Close()
+
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				Sinks: []config.AvailabilitySinkConfiguration{{
+					Name: "webhook",
+					Webhook: &config.WebhookSinkConfiguration{
+						Url:      server.URL,
+						Headers:  map[string]string{"Authorization": "Bearer token"},
+						Template: `{"up": {{ eq .Status "Up" }}, "reason": {{ json .OutageReason }}, "instances": {{ len .Instances }}}`,
+					},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sinks[0].Report(ctx, availabilityCollection)).To(Succeed())
+
+			Expect(header.Get("Authorization")).To(Equal("Bearer token"))
+			Expect(body).To(MatchJSON(`{"up": false, "reason": "1/3 monitored landscaper down", "instances": 2}`))
+		})
+
+		It("should fail if the endpoint does not accept the report", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				Sinks: []config.AvailabilitySinkConfiguration{{Name: "webhook", Webhook: &config.WebhookSinkConfiguration{Url: server.URL}}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sinks[0].Report(ctx, availabilityCollection)).To(MatchError(ContainSubstring("503")))
+		})
+	})
+
+	Context("AVS", func() {
+		It("should abort the upload when the context is cancelled", func() {
+			release := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			}))
+			defer server.Close()
+			defer close(release)
+
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				Sinks: []config.AvailabilitySinkConfiguration{{
+					Name: "avs",
+					AVS:  &config.AvailabilityServiceConfiguration{Url: server.URL, ApiKey: "key", Timeout: "30s"},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			cancelCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			Expect(sinks[0].Report(cancelCtx, availabilityCollection)).To(MatchError(ContainSubstring("context deadline exceeded")))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+	})
+
+	Context("File", func() {
+		It("should append the reports as json lines", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "availability.jsonl")
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				Sinks: []config.AvailabilitySinkConfiguration{{Name: "file", File: &config.FileSinkConfiguration{Path: path}}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sinks[0].Report(ctx, availabilityCollection)).To(Succeed())
+			Expect(sinks[0].Report(ctx, availabilityCollection)).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			Expect(lines).To(HaveLen(2))
+
+			report := &avuploader.Report{}
+			Expect(json.Unmarshal([]byte(lines[1]), report)).To(Succeed())
+			Expect(report.Status).To(Equal(avuploader.ReportStatusDown))
+			Expect(report.Instances).To(HaveLen(2))
+		})
+	})
+
+	Context("Pushgateway", func() {
+		It("should push the availability metrics of the job", func() {
+			var (
+				method, path string
+				body         []byte
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method = r.Method
+				path = r.URL.Path
+				body, _ = io.ReadAll(r.Body)
+			}))
+			defer server.Close()
+
+			sinks, err := avuploader.NewSinks(&config.AvailabilityMonitoringConfiguration{
+				Sinks: []config.AvailabilitySinkConfiguration{{
+					Name:        "pushgateway",
+					Pushgateway: &config.PushgatewaySinkConfiguration{Url: server.URL, Job: "availability"},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sinks[0].Report(ctx, availabilityCollection)).To(Succeed())
+
+			Expect(method).To(Equal(http.MethodPut))
+			Expect(path).To(Equal("/metrics/job/availability"))
+			Expect(string(body)).To(ContainSubstring("landscaper_service_availability_instance_up"))
+			Expect(string(body)).To(ContainSubstring("instance2-namespace"))
+		})
+	})
+
+	Context("Reporting to sinks", func() {
+		It("should track the last reported time of every sink", func() {
+			failing := &testSink{name: "failing", err: fmt.Errorf("unavailable")}
+			reporting := &testSink{name: "reporting"}
+			recorder := record.NewFakeRecorder(10)
+			op := operation.NewOperation(nil, nil, &config.LandscaperServiceConfiguration{}).WithEventRecorder(recorder)
+			ctrl := avuploader.NewTestActuator(*op, logging.Discard(), failing, reporting)
+
+			availabilityCollection.Status.Sinks = []lssv1alpha1.AvailabilitySinkStatus{
+				{Name: "removed", LastReported: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+			}
+			status := avuploader.ExportReportToSinks(ctrl, ctx, availabilityCollection)
+
+			Expect(status).To(HaveLen(2))
+			Expect(status[0].Name).To(Equal("failing"))
+			Expect(status[0].LastReported.IsZero()).To(BeTrue())
+			Expect(status[1].Name).To(Equal("reporting"))
+			Expect(status[1].LastReported.Equal(&availabilityCollection.Status.LastRun)).To(BeTrue())
+			Expect(reporting.reported).To(Equal(1))
+			Expect(recorder.Events).To(HaveLen(1))
+
+			// the sink which already reported the run is skipped
+			availabilityCollection.Status.Sinks = status
+			failing.err = nil
+			status = avuploader.ExportReportToSinks(ctrl, ctx, availabilityCollection)
+			Expect(status[0].LastReported.Equal(&availabilityCollection.Status.LastRun)).To(BeTrue())
+			Expect(failing.reported).To(Equal(1))
+			Expect(reporting.reported).To(Equal(1))
+		})
+
+		It("should take over the last reported time for the legacy avs sink", func() {
+			avs := &testSink{name: avuploader.LegacyAvsSinkName}
+			op := operation.NewOperation(nil, nil, &config.LandscaperServiceConfiguration{}).WithEventRecorder(record.NewFakeRecorder(10))
+			ctrl := avuploader.NewTestActuator(*op, logging.Discard(), avs)
+
+			availabilityCollection.Status.LastReported = availabilityCollection.Status.LastRun
+			status := avuploader.ExportReportToSinks(ctrl, ctx, availabilityCollection)
+			Expect(status).To(HaveLen(1))
+			Expect(status[0].LastReported.Equal(&availabilityCollection.Status.LastRun)).To(BeTrue())
+			Expect(avs.reported).To(Equal(0))
+		})
+	})
+})
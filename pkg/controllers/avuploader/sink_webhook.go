@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// webhookSink posts the availability report as json to an http endpoint.
+type webhookSink struct {
+	name     string
+	config   *config.WebhookSinkConfiguration
+	template *template.Template
+	client   *http.Client
+}
+
+func newWebhookSink(name string, webhookConfig *config.WebhookSinkConfiguration) (*webhookSink, error) {
+	if webhookConfig.Url == "" {
+		return nil, fmt.Errorf("webhook url must be set")
+	}
+
+	sink := &webhookSink{
+		name:   name,
+		config: webhookConfig,
+		client: &http.Client{Timeout: webhookConfig.Timeout.Duration},
+	}
+
+	if webhookConfig.Template != "" {
+		tmpl, err := template.New(name).Funcs(template.FuncMap{"json": toJson}).Option("missingkey=error").Parse(webhookConfig.Template)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse webhook template: %w", err)
+		}
+		sink.template = tmpl
+	}
+
+	return sink, nil
+}
+
+// Name implements Sink.
+func (s *webhookSink) Name() string {
+	return s.name
+}
+
+// Report implements Sink.
+func (s *webhookSink) Report(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) error {
+	body, err := s.renderBody(NewReport(availabilityCollection))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.Url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("webhook request build failed: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	for key, value := range s.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("webhook request failed to read response: %w", err)
+		}
+		return fmt.Errorf("webhook request failed with response code %d: %s", resp.StatusCode, resBody)
+	}
+	return nil
+}
+
+// renderBody renders the request body with the template, or marshals the report if no template is configured.
+func (s *webhookSink) renderBody(report *Report) ([]byte, error) {
+	if s.template == nil {
+		body, err := json.Marshal(report)
+		if err != nil {
+			return nil, fmt.Errorf("webhook payload json marshal failed: %w", err)
+		}
+		return body, nil
+	}
+
+	buf := bytes.Buffer{}
+	if err := s.template.Execute(&buf, report); err != nil {
+		return nil, fmt.Errorf("unable to render webhook template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("webhook template did not render valid json")
+	}
+	return buf.Bytes(), nil
+}
+
+// toJson marshals a value for the usage in a template.
+func toJson(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package avuploader
+
+import (
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// NewSnapshot creates the availability snapshot of the last health watcher run of an availability collection.
+// Only the failed landscapers are contained, to keep the snapshots small enough for the backlog in the status.
+func NewSnapshot(availabilityCollection *lssv1alpha1.AvailabilityCollection) lssv1alpha1.AvailabilitySnapshot {
+	snapshot := lssv1alpha1.AvailabilitySnapshot{
+		Timestamp: availabilityCollection.Status.LastRun,
+		//include self landscaper (--> +1 )
+		MonitoredInstances: len(availabilityCollection.Status.Instances) + 1,
+	}
+
+	for _, instance := range availabilityCollection.Status.Instances {
+		if instance.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+			snapshot.FailedInstances = append(snapshot.FailedInstances, newFailedAvailabilityInstance(instance))
+		}
+	}
+
+	self := availabilityCollection.Status.Self
+	if self.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+		failedInstance := newFailedAvailabilityInstance(self)
+		failedInstance.Self = true
+		snapshot.FailedInstances = append(snapshot.FailedInstances, failedInstance)
+	}
+
+	return snapshot
+}
+
+// NewTenantSnapshot creates the availability snapshot of the last health watcher run, restricted to the instances of a tenant.
+// The own landscaper is not contained, as it is not owned by the tenant.
+func NewTenantSnapshot(availabilityCollection *lssv1alpha1.AvailabilityCollection, tenantId string) lssv1alpha1.AvailabilitySnapshot {
+	snapshot := lssv1alpha1.AvailabilitySnapshot{
+		Timestamp: availabilityCollection.Status.LastRun,
+	}
+
+	for _, instance := range availabilityCollection.Status.Instances {
+		if instance.Identity == nil || instance.Identity.TenantId != tenantId {
+			continue
+		}
+		snapshot.MonitoredInstances++
+		if instance.Status == string(lsv1alpha1.LsHealthCheckStatusFailed) {
+			snapshot.FailedInstances = append(snapshot.FailedInstances, newFailedAvailabilityInstance(instance))
+		}
+	}
+
+	return snapshot
+}
+
+func newFailedAvailabilityInstance(instance lssv1alpha1.AvailabilityInstance) lssv1alpha1.FailedAvailabilityInstance {
+	return lssv1alpha1.FailedAvailabilityInstance{
+		ObjectReference: instance.ObjectReference,
+		Identity:        instance.Identity.DeepCopy(),
+		FailedReason:    instance.FailedReason,
+		FailureType:     instance.FailureType,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -17,9 +18,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -30,13 +29,16 @@ import (
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/installation"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
 type Controller struct {
 	operation.Operation
 	log                 logging.Logger
 	kubeClientExtractor ServiceTargetConfigKubeClientExtractorInterface
+	dataPlaneProber     DataPlaneProberInterface
 }
 
 // ServiceTargetConfigKubeClientExtractorInterface implements functionality to create a kubeclient from a servive target config ref
@@ -44,14 +46,15 @@ type ServiceTargetConfigKubeClientExtractorInterface interface {
 	GetKubeClientFromServiceTargetConfig(ctx context.Context, name string, namespace string, client client.Client) (client.Client, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 
-	op := operation.NewOperation(c, scheme, config)
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.Operation = *op
 	ctrl.kubeClientExtractor = &ServiceTargetConfigKubeClientExtractor{}
+	ctrl.dataPlaneProber = NewDataPlaneProber(config.AvailabilityMonitoring.DataPlaneProbeTimeout.Duration)
 	return ctrl, nil
 }
 
@@ -61,10 +64,17 @@ func NewTestActuator(op operation.Operation, kubeClientExtractor ServiceTargetCo
 		Operation:           op,
 		log:                 logger,
 		kubeClientExtractor: kubeClientExtractor,
+		dataPlaneProber:     NewDataPlaneProber(op.Config().AvailabilityMonitoring.DataPlaneProbeTimeout.Duration),
 	}
 	return ctrl
 }
 
+// WithDataPlaneProber sets the prober for the external data plane clusters of the instances.
+func (c *Controller) WithDataPlaneProber(dataPlaneProber DataPlaneProberInterface) *Controller {
+	c.dataPlaneProber = dataPlaneProber
+	return c
+}
+
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
 
@@ -87,103 +97,38 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	//clean status
 	oldInstances := availabilityCollection.Status.Instances
 	availabilityCollection.Status.Instances = []lssv1alpha1.AvailabilityInstance{}
+	watchedInstances := map[apitypes.NamespacedName]*lssv1alpha1.Instance{}
+	now := time.Now()
 
-	for _, instanceRefToWatch := range availabilityCollection.Spec.InstanceRefs {
-		logger, ctx := logging.FromContextOrNew(ctx, nil, "instance", apitypes.NamespacedName{Name: instanceRefToWatch.Name, Namespace: instanceRefToWatch.Namespace}.String())
-		logger.Debug("checking health of instance")
-
-		//get instance
-		logger.Debug("fetch instance")
-		instance := &lssv1alpha1.Instance{}
-		if err := c.Client().Get(ctx, apitypes.NamespacedName{Name: instanceRefToWatch.Name, Namespace: instanceRefToWatch.Namespace}, instance); err != nil {
-			logger.Error(err, "failed loading instance")
-			return reconcile.Result{}, err
-		}
-
-		availabilityInstance := c.createAvailabilityInstance(instance, oldInstances...)
-
-		//get referred installation
-		logger.Debug("fetch referred installation")
-		if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.Name == "" || instance.Status.InstallationRef.Namespace == "" {
-			logger.Debug("skip instance since installation ref is empty")
-			continue
+	for _, result := range c.checkInstances(ctx, availabilityCollection.Spec.InstanceRefs, oldInstances) {
+		if result.instance != nil {
+			watchedInstances[client.ObjectKeyFromObject(result.instance)] = result.instance
 		}
-		installation := &lsv1alpha1.Installation{}
-		if err := c.Client().Get(ctx, apitypes.NamespacedName{Name: instance.Status.InstallationRef.Name, Namespace: instance.Status.InstallationRef.Namespace}, installation); err != nil {
-			logger.Error(err, "could not load installation from installation reference")
-			if apierrors.IsNotFound(err) {
-				logger.Error(err, "skipping instance monitoring")
-				continue
+		if result.availabilityInstance == nil {
+			//an instance which is skipped in this run, e.g. during an upgrade, keeps its previous status and history
+			if previous := findAvailabilityInstance(oldInstances, result.instance); previous != nil {
+				availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *previous.DeepCopy())
 			}
-			msg := "could not load installation from installation reference"
-			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-			availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
-			continue
-		}
-
-		//check if installation is not progressing
-		if !installation.Status.InstallationPhase.IsFinal() {
-			logger.Info("installation for instance is not in final phase, skip health check monitoring", lc.KeyResource, client.ObjectKeyFromObject(installation).String(), "phase", installation.Status.InstallationPhase)
-			continue
-		}
-
-		//check that servicetargetconfref exists exists
-		logger.Debug("check servcicetargetconfref existance")
-		if instance.Spec.ServiceTargetConfigRef.Name == "" || instance.Spec.ServiceTargetConfigRef.Namespace == "" {
-			logger.Info("instance does not have a ServiceTargetConfig ref")
-			msg := "instance does not have a ServiceTargetConfigRef"
-			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-			availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
 			continue
 		}
-
-		//get kubeconfig from secret referenced in ServiceTargetConfig so a credential rotation is automatically handled
-		logger.Debug("get target kubeClient from service target config")
-		targetClient, err := c.kubeClientExtractor.GetKubeClientFromServiceTargetConfig(ctx, instance.Spec.ServiceTargetConfigRef.Name, instance.Spec.ServiceTargetConfigRef.Namespace, c.Client())
-		if err != nil {
-			logger.Error(err, "failed creating target client")
-			msg := "could not create k8s client from target config"
-			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-			availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
-			continue
+		UpdateAvailabilityHistory(result.availabilityInstance, now)
+		availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *result.availabilityInstance)
+		if result.availabilityInstance.Status != string(lssv1alpha1.LsHealthCheckStatusUnknown) {
+			metrics.SetHealthCheckStatus(result.availabilityInstance.ObjectReference,
+				result.availabilityInstance.Status != string(lsv1alpha1.LsHealthCheckStatusFailed), result.duration)
 		}
-		logger.Debug("fetch target namespace from installation")
-		targetClusterNamespace, err := extractTargetClusterNamespaceFromInstallation(*installation)
-		if err != nil {
-			logger.Error(err, "failed extracting target cluster namespace")
-			msg := "could not read target cluster namespace from installation"
-			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-			availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
-			continue
-		}
-
-		//collect lshealthcheck
-		logger.Debug("collect lshealthcheck")
-		lsHealthchecks := &lsv1alpha1.LsHealthCheckList{}
-		err = targetClient.List(ctx, lsHealthchecks, client.InNamespace(targetClusterNamespace))
-		if err != nil {
-			logger.Error(err, "could not load lshealthcheck from cluster")
-			if apierrors.IsNotFound(err) {
-				msg := "lsHealthCheck not found on target"
-				availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-				continue
-			}
-			msg := "failed retrieving lshealthcheck cr"
-			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
-			availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
-			continue
-		}
-
-		TransferLsHealthCheckStatusToAvailabilityInstance(availabilityInstance, lsHealthchecks, c.Config().AvailabilityMonitoring.LSHealthCheckTimeout.Duration)
-		availabilityCollection.Status.Instances = append(availabilityCollection.Status.Instances, *availabilityInstance)
-		logger.Debug("healthcheck of instance completed", "health", availabilityInstance.Status)
+		setAvailabilityMetrics(result.availabilityInstance, now)
 	}
+	deleteHealthCheckMetrics(oldInstances, availabilityCollection.Status.Instances)
 	availabilityCollection.Status.Self = c.getLsHealthCheckFromSelfLandscaper(ctx,
 		c.Config().AvailabilityMonitoring.SelfLandscaperNamespace, availabilityCollection.Status.Self)
+	UpdateAvailabilityHistory(&availabilityCollection.Status.Self, now)
+	LimitAvailabilityHistorySize(&availabilityCollection.Status, lssv1alpha1.AvailabilityHistoryMaxSize)
 	availabilityCollection.Status.ObservedGeneration = availabilityCollection.Generation
-	availabilityCollection.Status.LastRun = v1.NewTime(time.Now())
+	availabilityCollection.Status.LastRun = v1.NewTime(now)
 
 	logFailedInstances(logger, *availabilityCollection)
+	c.recordAvailabilityEvents(watchedInstances, oldInstances, availabilityCollection.Status.Instances)
 
 	//write to status
 	logger.Debug("updating status")
@@ -198,6 +143,238 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 }
 
+// checkResult is the result of the health check of a watched instance.
+// The instance is nil if it could not be loaded, the availability instance is nil if the instance is skipped in this run.
+type checkResult struct {
+	instance             *lssv1alpha1.Instance
+	availabilityInstance *lssv1alpha1.AvailabilityInstance
+	duration             time.Duration
+}
+
+// checkInstances checks the health of the watched instances concurrently.
+// At most the configured number of checks run at the same time.
+// The results are returned in the order of the instance references.
+func (c *Controller) checkInstances(ctx context.Context, instanceRefs []lssv1alpha1.ObjectReference,
+	oldInstances []lssv1alpha1.AvailabilityInstance) []checkResult {
+
+	results := make([]checkResult, len(instanceRefs))
+	indices := make(chan int)
+
+	workers := min(c.Config().AvailabilityMonitoring.CheckConcurrency, len(instanceRefs))
+	if workers < 1 {
+		workers = 1
+	}
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = c.checkInstance(ctx, instanceRefs[i], oldInstances)
+			}
+		}()
+	}
+
+	for i := range instanceRefs {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results
+}
+
+// checkInstance checks the health of a watched instance within the configured instance check timeout.
+// An instance whose check doesn't finish in time is reported as failed.
+// An instance which has been deleted in the meantime is skipped, an instance which could not be loaded
+// gets the status unknown, so that the check of the other instances is not affected.
+func (c *Controller) checkInstance(ctx context.Context, instanceRefToWatch lssv1alpha1.ObjectReference,
+	oldInstances []lssv1alpha1.AvailabilityInstance) checkResult {
+
+	start := time.Now()
+	logger, ctx := logging.FromContextOrNew(ctx, nil, "instance", instanceRefToWatch.NamespacedName().String())
+	logger.Debug("checking health of instance")
+
+	//get instance
+	logger.Debug("fetch instance")
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, instanceRefToWatch.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("skip instance since it has been deleted")
+			return checkResult{}
+		}
+		logger.Error(err, "failed loading instance")
+		availabilityInstance := c.createAvailabilityInstance(instanceRefToWatch, oldInstances...)
+		availabilityInstance.Status = string(lssv1alpha1.LsHealthCheckStatusUnknown)
+		availabilityInstance.FailedReason = fmt.Sprintf("could not load instance: %s", err.Error())
+		return checkResult{availabilityInstance: availabilityInstance, duration: time.Since(start)}
+	}
+
+	timeout := c.Config().AvailabilityMonitoring.InstanceCheckTimeout.Duration
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// the check runs in its own go routine, so that a check which doesn't respect the context can't block the worker
+	done := make(chan *lssv1alpha1.AvailabilityInstance, 1)
+	go func() {
+		done <- c.checkInstanceHealth(checkCtx, instance.DeepCopy(), oldInstances)
+	}()
+
+	var availabilityInstance *lssv1alpha1.AvailabilityInstance
+	select {
+	case availabilityInstance = <-done:
+	case <-checkCtx.Done():
+		logger.Info("health check of instance timed out", "timeout", timeout.String())
+		availabilityInstance = c.createAvailabilityInstance(instanceRefToWatch, oldInstances...)
+		msg := fmt.Sprintf("timeout - health check did not finish within %s", timeout.String())
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+	}
+
+	if availabilityInstance != nil {
+		availabilityInstance.Identity = c.getInstanceIdentity(ctx, instance)
+		setFailureType(availabilityInstance)
+	}
+	return checkResult{instance: instance, availabilityInstance: availabilityInstance, duration: time.Since(start)}
+}
+
+// getInstanceIdentity returns the identity of an instance, including the purpose of the owning LandscaperDeployment.
+// The purpose is left empty if the LandscaperDeployment could not be loaded, as it doesn't affect the availability.
+func (c *Controller) getInstanceIdentity(ctx context.Context, instance *lssv1alpha1.Instance) *lssv1alpha1.InstanceIdentity {
+	identity := &lssv1alpha1.InstanceIdentity{
+		TenantId:   instance.Spec.TenantId,
+		InstanceId: instance.Spec.ID,
+	}
+
+	owner := v1.GetControllerOf(instance)
+	if owner == nil || owner.Kind != "LandscaperDeployment" {
+		return identity
+	}
+	identity.LandscaperDeployment = owner.Name
+
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if err := c.Client().Get(ctx, apitypes.NamespacedName{Name: owner.Name, Namespace: instance.Namespace}, deployment); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, nil)
+		logger.Info("could not load landscaper deployment of instance", "landscaperDeployment", owner.Name, "error", err.Error())
+		return identity
+	}
+	identity.Purpose = deployment.Spec.Purpose
+	return identity
+}
+
+// checkInstanceHealth checks the health of the landscaper and the external data plane cluster of a watched instance.
+// The returned availability instance is nil if the instance is skipped in this run.
+func (c *Controller) checkInstanceHealth(ctx context.Context, instance *lssv1alpha1.Instance,
+	oldInstances []lssv1alpha1.AvailabilityInstance) *lssv1alpha1.AvailabilityInstance {
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	availabilityInstance := c.createAvailabilityInstance(lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}, oldInstances...)
+
+	//a hibernated instance has no running landscaper and must not be reported as failed
+	if instance.Status.Hibernation != nil && instance.Status.Hibernation.Hibernated {
+		logger.Debug("skip health check since instance is hibernated")
+		availabilityInstance.SetStatusAndFailedSince(lssv1alpha1.LsHealthCheckStatusHibernated, "", false)
+		return availabilityInstance
+	}
+
+	//get referred installation
+	logger.Debug("fetch referred installation")
+	if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.Name == "" || instance.Status.InstallationRef.Namespace == "" {
+		logger.Debug("skip instance since installation ref is empty")
+		return nil
+	}
+	installation := &lsv1alpha1.Installation{}
+	if err := c.Client().Get(ctx, apitypes.NamespacedName{Name: instance.Status.InstallationRef.Name, Namespace: instance.Status.InstallationRef.Namespace}, installation); err != nil {
+		logger.Error(err, "could not load installation from installation reference")
+		if apierrors.IsNotFound(err) {
+			logger.Error(err, "skipping instance monitoring")
+			return nil
+		}
+		msg := "could not load installation from installation reference"
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		return availabilityInstance
+	}
+
+	//check if installation is not progressing
+	if !installation.Status.InstallationPhase.IsFinal() {
+		logger.Info("installation for instance is not in final phase, skip health check monitoring", lc.KeyResource, client.ObjectKeyFromObject(installation).String(), "phase", installation.Status.InstallationPhase)
+		return nil
+	}
+
+	//the data plane cluster is probed independently of the landscaper, so that it is also detected as root cause if the landscaper can't be checked
+	var dataPlaneErr error
+	if instance.IsExternalDataPlane() {
+		logger.Debug("probe external data plane cluster")
+		if dataPlaneErr = c.dataPlaneProber.ProbeDataPlane(ctx, instance, c.Client()); dataPlaneErr != nil {
+			logger.Info("external data plane cluster is not available", "error", dataPlaneErr.Error())
+		}
+	}
+
+	if skip := c.checkLandscaperHealth(ctx, instance, installation, availabilityInstance); skip {
+		return nil
+	}
+
+	TransferDataPlaneStatusToAvailabilityInstance(availabilityInstance, dataPlaneErr, c.Config().AvailabilityMonitoring.LSHealthCheckTimeout.Duration)
+
+	logger.Debug("healthcheck of instance completed", "health", availabilityInstance.Status)
+	return availabilityInstance
+}
+
+// checkLandscaperHealth checks the landscaper of a watched instance with the lshealthcheck on its hosting cluster
+// and sets the result in the availability instance. It returns true if the instance is skipped in this run.
+func (c *Controller) checkLandscaperHealth(ctx context.Context, instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation,
+	availabilityInstance *lssv1alpha1.AvailabilityInstance) bool {
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	//check that servicetargetconfref exists exists
+	logger.Debug("check servcicetargetconfref existance")
+	if instance.Spec.ServiceTargetConfigRef.Name == "" || instance.Spec.ServiceTargetConfigRef.Namespace == "" {
+		logger.Info("instance does not have a ServiceTargetConfig ref")
+		msg := "instance does not have a ServiceTargetConfigRef"
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		return false
+	}
+
+	//get kubeconfig from secret referenced in ServiceTargetConfig so a credential rotation is automatically handled
+	logger.Debug("get target kubeClient from service target config")
+	targetClient, err := c.kubeClientExtractor.GetKubeClientFromServiceTargetConfig(ctx, instance.Spec.ServiceTargetConfigRef.Name, instance.Spec.ServiceTargetConfigRef.Namespace, c.Client())
+	if err != nil {
+		logger.Error(err, "failed creating target client")
+		msg := "could not create k8s client from target config"
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		return false
+	}
+	logger.Debug("fetch target namespace from installation")
+	targetClusterNamespace, err := extractTargetClusterNamespaceFromInstallation(*installation)
+	if err != nil {
+		logger.Error(err, "failed extracting target cluster namespace")
+		msg := "could not read target cluster namespace from installation"
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		return false
+	}
+
+	//collect lshealthcheck
+	logger.Debug("collect lshealthcheck")
+	lsHealthchecks := &lsv1alpha1.LsHealthCheckList{}
+	err = targetClient.List(ctx, lsHealthchecks, client.InNamespace(targetClusterNamespace))
+	if err != nil {
+		logger.Error(err, "could not load lshealthcheck from cluster")
+		if apierrors.IsNotFound(err) {
+			msg := "lsHealthCheck not found on target"
+			availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+			return true
+		}
+		msg := "failed retrieving lshealthcheck cr"
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		return false
+	}
+
+	TransferLsHealthCheckStatusToAvailabilityInstance(availabilityInstance, lsHealthchecks, c.Config().AvailabilityMonitoring.LSHealthCheckTimeout.Duration)
+	return false
+}
+
 func (c *Controller) getLsHealthCheckFromSelfLandscaper(ctx context.Context, namespace string,
 	oldInstance lssv1alpha1.AvailabilityInstance) lssv1alpha1.AvailabilityInstance {
 
@@ -206,7 +383,9 @@ func (c *Controller) getLsHealthCheckFromSelfLandscaper(ctx context.Context, nam
 			Name:      "self",
 			Namespace: namespace,
 		},
-		FailedSince: oldInstance.FailedSince,
+		FailedSince:    oldInstance.FailedSince,
+		MonitoredSince: oldInstance.MonitoredSince,
+		Outages:        append([]lssv1alpha1.Outage(nil), oldInstance.Outages...),
 	}
 
 	//collect lshealthcheck
@@ -238,22 +417,27 @@ func (c *Controller) getLsHealthCheckFromSelfLandscaper(ctx context.Context, nam
 		}
 	}
 	TransferLsHealthCheckStatusToAvailabilityInstance(&availabilityInstance, lsHealthchecks, c.Config().AvailabilityMonitoring.LSHealthCheckTimeout.Duration)
+	setFailureType(&availabilityInstance)
 	return availabilityInstance
 }
 
-func (c *Controller) createAvailabilityInstance(instance *lssv1alpha1.Instance,
+func (c *Controller) createAvailabilityInstance(ref lssv1alpha1.ObjectReference,
 	oldInstances ...lssv1alpha1.AvailabilityInstance) *lssv1alpha1.AvailabilityInstance {
 
 	availabilityInstance := lssv1alpha1.AvailabilityInstance{
 		ObjectReference: lssv1alpha1.ObjectReference{
-			Name:      instance.Name,
-			Namespace: instance.Namespace,
+			Name:      ref.Name,
+			Namespace: ref.Namespace,
 		},
 	}
 
 	for _, next := range oldInstances {
-		if next.Name == instance.Name && next.Namespace == instance.Namespace {
+		if next.Name == ref.Name && next.Namespace == ref.Namespace {
 			availabilityInstance.FailedSince = next.FailedSince
+			availabilityInstance.DataPlaneFailedSince = next.DataPlaneFailedSince
+			availabilityInstance.Identity = next.Identity
+			availabilityInstance.MonitoredSince = next.MonitoredSince
+			availabilityInstance.Outages = append([]lssv1alpha1.Outage(nil), next.Outages...)
 			break
 		}
 	}
@@ -261,6 +445,78 @@ func (c *Controller) createAvailabilityInstance(instance *lssv1alpha1.Instance,
 	return &availabilityInstance
 }
 
+// setFailureType sets the failure type of a failed availability instance, which is the landscaper
+// unless the external data plane cluster has been detected as unavailable, and clears it otherwise.
+func setFailureType(availabilityInstance *lssv1alpha1.AvailabilityInstance) {
+	if availabilityInstance.Status != string(lsv1alpha1.LsHealthCheckStatusFailed) {
+		availabilityInstance.FailureType = ""
+	} else if availabilityInstance.FailureType == "" {
+		availabilityInstance.FailureType = lssv1alpha1.AvailabilityFailureTypeLandscaper
+	}
+}
+
+// findAvailabilityInstance returns the availability instance of the given instance, or nil if the instance is nil or not contained.
+func findAvailabilityInstance(availabilityInstances []lssv1alpha1.AvailabilityInstance, instance *lssv1alpha1.Instance) *lssv1alpha1.AvailabilityInstance {
+	if instance == nil {
+		return nil
+	}
+	for i := range availabilityInstances {
+		if availabilityInstances[i].Name == instance.Name && availabilityInstances[i].Namespace == instance.Namespace {
+			return &availabilityInstances[i]
+		}
+	}
+	return nil
+}
+
+// recordAvailabilityEvents records an event for every instance whose availability status has changed from or to failed.
+func (c *Controller) recordAvailabilityEvents(watchedInstances map[apitypes.NamespacedName]*lssv1alpha1.Instance,
+	oldInstances, newInstances []lssv1alpha1.AvailabilityInstance) {
+
+	for _, newInstance := range newInstances {
+		instance, ok := watchedInstances[newInstance.NamespacedName()]
+		if !ok {
+			continue
+		}
+
+		oldStatus := ""
+		for _, oldInstance := range oldInstances {
+			if oldInstance.Name == newInstance.Name && oldInstance.Namespace == newInstance.Namespace {
+				oldStatus = oldInstance.Status
+				break
+			}
+		}
+
+		failed := string(lsv1alpha1.LsHealthCheckStatusFailed)
+		if newInstance.Status == failed && oldStatus != failed {
+			c.EventRecorder().Eventf(instance, corev1.EventTypeWarning, operation.EventReasonUnavailable,
+				"Landscaper is unavailable: %s", newInstance.FailedReason)
+		} else if newInstance.Status == string(lsv1alpha1.LsHealthCheckStatusOk) && oldStatus == failed {
+			c.EventRecorder().Event(instance, corev1.EventTypeNormal, operation.EventReasonAvailable, "Landscaper is available again")
+		}
+	}
+}
+
+// setAvailabilityMetrics records the availability of an instance within the rolling windows.
+func setAvailabilityMetrics(availabilityInstance *lssv1alpha1.AvailabilityInstance, now time.Time) {
+	for _, window := range availabilityWindows {
+		metrics.SetInstanceAvailability(availabilityInstance.ObjectReference, window.label,
+			ComputeAvailability(availabilityInstance, window.duration, now))
+	}
+}
+
+// deleteHealthCheckMetrics removes the health check metrics of the instances which have not been checked in this run.
+func deleteHealthCheckMetrics(oldInstances, newInstances []lssv1alpha1.AvailabilityInstance) {
+	checked := map[apitypes.NamespacedName]bool{}
+	for _, newInstance := range newInstances {
+		checked[newInstance.NamespacedName()] = true
+	}
+	for _, oldInstance := range oldInstances {
+		if !checked[oldInstance.NamespacedName()] {
+			metrics.DeleteHealthCheckStatus(oldInstance.ObjectReference)
+		}
+	}
+}
+
 func logFailedInstances(logger logging.Logger, availabilityCollection lssv1alpha1.AvailabilityCollection) {
 	failedInstances := []lssv1alpha1.AvailabilityInstance{}
 
@@ -309,6 +565,31 @@ func TransferLsHealthCheckStatusToAvailabilityInstance(availabilityInstance *lss
 	}
 }
 
+// TransferDataPlaneStatusToAvailabilityInstance sets the result of the probe of the external data plane cluster in the availability instance.
+// An unusable data plane cluster is the root cause of a failing landscaper, therefore it overrides the landscaper status,
+// but like a failing landscaper only if the probe still fails after the timeout.
+func TransferDataPlaneStatusToAvailabilityInstance(availabilityInstance *lssv1alpha1.AvailabilityInstance, probeErr error, timeout time.Duration) {
+	if probeErr == nil {
+		availabilityInstance.DataPlaneFailedSince = nil
+		return
+	}
+
+	if availabilityInstance.DataPlaneFailedSince == nil {
+		now := v1.Now()
+		availabilityInstance.DataPlaneFailedSince = &now
+	}
+
+	if time.Since(availabilityInstance.DataPlaneFailedSince.Time) > timeout {
+		msg := fmt.Sprintf("data plane cluster: %s", probeErr.Error())
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, msg, true)
+		availabilityInstance.FailureType = lssv1alpha1.AvailabilityFailureTypeDataPlane
+	} else if availabilityInstance.Status != string(lsv1alpha1.LsHealthCheckStatusFailed) {
+		// the status remains unchanged, but the failure of the data plane cluster is put as remark in failedReason
+		availabilityInstance.FailedReason = fmt.Sprintf("data plane cluster: failed - waiting for timeout (%s) to transition to status=Failed: %s",
+			timeout.String(), probeErr.Error())
+	}
+}
+
 func extractTargetClusterNamespaceFromInstallation(inst lsv1alpha1.Installation) (string, error) {
 	hostingClusterNamespaceRaw, ok := inst.Spec.ImportDataMappings[installation.HostingClusterNamespaceImportName]
 	if !ok {
@@ -321,6 +602,8 @@ func extractTargetClusterNamespaceFromInstallation(inst lsv1alpha1.Installation)
 	return targetClusterNamespace, nil
 }
 
+// ServiceTargetConfigKubeClientExtractor creates the clients for the hosting clusters of the service target configs.
+// The clients are kept in the shared client cache, until the kubeconfig secret of the service target config changes.
 type ServiceTargetConfigKubeClientExtractor struct{}
 
 func (e *ServiceTargetConfigKubeClientExtractor) GetKubeClientFromServiceTargetConfig(ctx context.Context, name string, namespace string, client client.Client) (client.Client, error) {
@@ -342,35 +625,9 @@ func (e *ServiceTargetConfigKubeClientExtractor) GetKubeClientFromServiceTargetC
 		return nil, fmt.Errorf("could not load secret %s:%s for ServiceTargetConfig %s:%s: %w", serviceTargetConfig.Spec.SecretRef.Name, serviceTargetConfig.Spec.SecretRef.Namespace, name, namespace, err)
 	}
 
-	_, targetClient, _, err := getKubeClientFromSecret(*secretWithKubeconf, serviceTargetConfig.Spec.SecretRef.Key)
+	targetClient, err := utils.SharedClientCache.GetOrCreate(apitypes.NamespacedName{Name: name, Namespace: namespace}, secretWithKubeconf, serviceTargetConfig.Spec.SecretRef.Key)
 	if err != nil {
 		return nil, fmt.Errorf("failed building kubeclient for target: %w", err)
 	}
 	return targetClient, nil
 }
-
-func getKubeClientFromSecret(secret corev1.Secret, key string) (*rest.Config, client.Client, kubernetes.Interface, error) {
-	kubeconfigBytes, ok := secret.Data[key]
-	if !ok {
-		return nil, nil, nil, fmt.Errorf("could not found key %s in secret", key)
-	}
-
-	kubeconfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	restConfig, err := kubeconfig.ClientConfig()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	kubeClient, err := client.New(restConfig, client.Options{})
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	clientset, err := kubernetes.NewForConfig(restConfig)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	return restConfig, kubeClient, clientset, nil
-}
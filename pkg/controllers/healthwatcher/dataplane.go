@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// requiredLandscaperResources are the landscaper resources which have to be served by an external data plane cluster.
+var requiredLandscaperResources = []string{
+	"installations",
+	"executions",
+	"deployitems",
+	"targets",
+	"contexts",
+	"dataobjects",
+}
+
+// DataPlaneProberInterface implements functionality to check whether the external data plane cluster of an instance is usable.
+type DataPlaneProberInterface interface {
+	ProbeDataPlane(ctx context.Context, instance *lssv1alpha1.Instance, client client.Client) error
+}
+
+// DataPlaneProber probes the external data plane cluster of an instance with the kubeconfig of its data plane spec.
+type DataPlaneProber struct {
+	timeout time.Duration
+}
+
+// NewDataPlaneProber creates a new data plane prober, whose requests are limited by the given timeout.
+func NewDataPlaneProber(timeout time.Duration) *DataPlaneProber {
+	return &DataPlaneProber{timeout: timeout}
+}
+
+// ProbeDataPlane checks that the api server of the external data plane cluster is reachable
+// and that the landscaper crds are installed and served.
+func (p *DataPlaneProber) ProbeDataPlane(ctx context.Context, instance *lssv1alpha1.Instance, client client.Client) error {
+	kubeconfig, err := utils.GetDataPlaneKubeconfig(ctx, client, instance.Spec.DataPlane)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	restConfig.Timeout = p.timeout
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating discovery client: %w", err)
+	}
+	return probeDataPlane(discoveryClient)
+}
+
+// probeDataPlane checks the data plane cluster behind the given discovery client.
+func probeDataPlane(discoveryClient discovery.DiscoveryInterface) error {
+	if _, err := discoveryClient.ServerVersion(); err != nil {
+		return fmt.Errorf("api server not reachable: %w", err)
+	}
+
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(lsv1alpha1.SchemeGroupVersion.String())
+	if err != nil {
+		return fmt.Errorf("landscaper crds are not served: %w", err)
+	}
+
+	served := map[string]bool{}
+	for _, resource := range resourceList.APIResources {
+		served[resource.Name] = true
+	}
+
+	missing := []string{}
+	for _, resource := range requiredLandscaperResources {
+		if !served[resource] {
+			missing = append(missing, resource)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("landscaper crds are not served: missing %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
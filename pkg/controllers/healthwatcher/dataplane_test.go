@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher_test
+
+import (
+	"fmt"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	healthwatcher "github.com/gardener/landscaper-service/pkg/controllers/healthwatcher"
+)
+
+var _ = Describe("Data Plane Probe", func() {
+	var discoveryClient *discoveryfake.FakeDiscovery
+
+	landscaperResources := func(names ...string) *v1.APIResourceList {
+		resourceList := &v1.APIResourceList{GroupVersion: lsv1alpha1.SchemeGroupVersion.String()}
+		for _, name := range names {
+			resourceList.APIResources = append(resourceList.APIResources, v1.APIResource{Name: name, Namespaced: true})
+		}
+		return resourceList
+	}
+
+	BeforeEach(func() {
+		discoveryClient = &discoveryfake.FakeDiscovery{Fake: &k8stesting.Fake{}}
+	})
+
+	It("should succeed if the landscaper crds are served", func() {
+		discoveryClient.Resources = []*v1.APIResourceList{
+			landscaperResources("installations", "executions", "deployitems", "targets", "contexts", "dataobjects", "targetsyncs"),
+		}
+		Expect(healthwatcher.ExportProbeDataPlane(discoveryClient)).To(Succeed())
+	})
+
+	It("should fail if the api server is not reachable", func() {
+		discoveryClient.AddReactor("get", "version", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("connection refused")
+		})
+		err := healthwatcher.ExportProbeDataPlane(discoveryClient)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("api server not reachable: connection refused"))
+	})
+
+	It("should fail if the landscaper crds are not installed", func() {
+		err := healthwatcher.ExportProbeDataPlane(discoveryClient)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("landscaper crds are not served"))
+	})
+
+	It("should fail if a landscaper crd is missing", func() {
+		discoveryClient.Resources = []*v1.APIResourceList{
+			landscaperResources("installations", "executions", "targets", "contexts"),
+		}
+		err := healthwatcher.ExportProbeDataPlane(discoveryClient)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("landscaper crds are not served: missing deployitems, dataobjects"))
+	})
+})
@@ -0,0 +1,7 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher
+
+var ExportProbeDataPlane = probeDataPlane
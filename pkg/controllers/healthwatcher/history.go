@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// AvailabilityWindowDay is the rolling window of the daily availability.
+	AvailabilityWindowDay = 24 * time.Hour
+	// AvailabilityWindowWeek is the rolling window of the weekly availability.
+	AvailabilityWindowWeek = 7 * AvailabilityWindowDay
+	// AvailabilityWindowMonth is the rolling window of the monthly availability.
+	// It is also the period for which the outages of an instance are kept.
+	AvailabilityWindowMonth = 30 * AvailabilityWindowDay
+
+	// MaxOutages is the maximum number of outages which are kept per instance.
+	// If an instance has more outages within the history period, the oldest ones are dropped.
+	MaxOutages = 100
+)
+
+// availabilityWindows maps the metric label values of the rolling windows to their durations.
+var availabilityWindows = []struct {
+	label    string
+	duration time.Duration
+}{
+	{label: "1d", duration: AvailabilityWindowDay},
+	{label: "7d", duration: AvailabilityWindowWeek},
+	{label: "30d", duration: AvailabilityWindowMonth},
+}
+
+// UpdateAvailabilityHistory records the current status of an availability instance in its outage history
+// and recomputes its availability percentages.
+// A failed status opens a new outage if none is ongoing, any other known status ends the ongoing outage.
+// An unknown status leaves the history unchanged, because nothing is known about the instance.
+func UpdateAvailabilityHistory(availabilityInstance *lssv1alpha1.AvailabilityInstance, now time.Time) {
+	if availabilityInstance.MonitoredSince == nil {
+		monitoredSince := metav1.NewTime(now)
+		availabilityInstance.MonitoredSince = &monitoredSince
+	}
+
+	ongoing := ongoingOutage(availabilityInstance.Outages)
+	switch availabilityInstance.Status {
+	case string(lssv1alpha1.LsHealthCheckStatusUnknown):
+	case string(lsv1alpha1.LsHealthCheckStatusFailed):
+		if ongoing == nil {
+			start := now
+			if availabilityInstance.FailedSince != nil && availabilityInstance.FailedSince.Time.Before(now) {
+				start = availabilityInstance.FailedSince.Time
+			}
+			availabilityInstance.Outages = append(availabilityInstance.Outages, lssv1alpha1.Outage{
+				Start:  metav1.NewTime(start),
+				Reason: availabilityInstance.FailedReason,
+			})
+		}
+	default:
+		if ongoing != nil {
+			end := metav1.NewTime(now)
+			ongoing.End = &end
+		}
+	}
+
+	availabilityInstance.Outages = pruneOutages(availabilityInstance.Outages, now)
+	availabilityInstance.Availability = &lssv1alpha1.Availability{
+		Day:   formatAvailability(ComputeAvailability(availabilityInstance, AvailabilityWindowDay, now)),
+		Week:  formatAvailability(ComputeAvailability(availabilityInstance, AvailabilityWindowWeek, now)),
+		Month: formatAvailability(ComputeAvailability(availabilityInstance, AvailabilityWindowMonth, now)),
+	}
+}
+
+// LimitAvailabilityHistorySize drops the oldest ended outages of the instances and the own landscaper
+// until their serialized size doesn't exceed the given maximum size in bytes.
+// Ongoing outages are never dropped.
+func LimitAvailabilityHistorySize(status *lssv1alpha1.AvailabilityCollectionStatus, maxSize int) {
+	availabilityInstances := make([]*lssv1alpha1.AvailabilityInstance, 0, len(status.Instances)+1)
+	for i := range status.Instances {
+		availabilityInstances = append(availabilityInstances, &status.Instances[i])
+	}
+	availabilityInstances = append(availabilityInstances, &status.Self)
+
+	size := 0
+	for _, availabilityInstance := range availabilityInstances {
+		size += jsonSize(availabilityInstance)
+	}
+
+	for size > maxSize {
+		var oldest *lssv1alpha1.AvailabilityInstance
+		for _, availabilityInstance := range availabilityInstances {
+			if len(availabilityInstance.Outages) == 0 || availabilityInstance.Outages[0].End == nil {
+				continue
+			}
+			if oldest == nil || availabilityInstance.Outages[0].Start.Before(&oldest.Outages[0].Start) {
+				oldest = availabilityInstance
+			}
+		}
+		if oldest == nil {
+			return
+		}
+
+		// the outage and its separator
+		size -= jsonSize(oldest.Outages[0]) + 1
+		oldest.Outages = oldest.Outages[1:]
+		if len(oldest.Outages) == 0 {
+			oldest.Outages = nil
+		}
+	}
+}
+
+// ComputeAvailability computes the availability of an instance in percent within the given rolling window.
+// The window is shortened to the time the instance has been monitored, an instance which has not been
+// monitored at all is considered to be fully available.
+func ComputeAvailability(availabilityInstance *lssv1alpha1.AvailabilityInstance, window time.Duration, now time.Time) float64 {
+	windowStart := now.Add(-window)
+	if availabilityInstance.MonitoredSince != nil && availabilityInstance.MonitoredSince.Time.After(windowStart) {
+		windowStart = availabilityInstance.MonitoredSince.Time
+	}
+
+	total := now.Sub(windowStart)
+	if total <= 0 {
+		return 100
+	}
+
+	var downtime time.Duration
+	for _, outage := range availabilityInstance.Outages {
+		start := outage.Start.Time
+		if start.Before(windowStart) {
+			start = windowStart
+		}
+		end := now
+		if outage.End != nil && outage.End.Time.Before(now) {
+			end = outage.End.Time
+		}
+		if end.After(start) {
+			downtime += end.Sub(start)
+		}
+	}
+
+	if downtime >= total {
+		return 0
+	}
+	return 100 * float64(total-downtime) / float64(total)
+}
+
+// ongoingOutage returns the outage which has not ended yet, or nil if there is none.
+func ongoingOutage(outages []lssv1alpha1.Outage) *lssv1alpha1.Outage {
+	if len(outages) == 0 || outages[len(outages)-1].End != nil {
+		return nil
+	}
+	return &outages[len(outages)-1]
+}
+
+// pruneOutages removes the outages which have ended before the history period
+// and limits the number of outages to the most recent ones.
+func pruneOutages(outages []lssv1alpha1.Outage, now time.Time) []lssv1alpha1.Outage {
+	historyStart := now.Add(-AvailabilityWindowMonth)
+	pruned := make([]lssv1alpha1.Outage, 0, len(outages))
+	for _, outage := range outages {
+		if outage.End != nil && outage.End.Time.Before(historyStart) {
+			continue
+		}
+		pruned = append(pruned, outage)
+	}
+	if len(pruned) > MaxOutages {
+		pruned = pruned[len(pruned)-MaxOutages:]
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+	return pruned
+}
+
+func formatAvailability(percent float64) string {
+	return fmt.Sprintf("%.3f", percent)
+}
+
+func jsonSize(v interface{}) int {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return len(raw)
+}
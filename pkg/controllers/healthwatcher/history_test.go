@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher_test
+
+import (
+	"strings"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	healthwatcher "github.com/gardener/landscaper-service/pkg/controllers/healthwatcher"
+)
+
+var _ = Describe("Availability History", func() {
+	var (
+		now                  time.Time
+		availabilityInstance *lssv1alpha1.AvailabilityInstance
+	)
+
+	timeRef := func(t time.Time) *metav1.Time {
+		mt := metav1.NewTime(t)
+		return &mt
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+		availabilityInstance = &lssv1alpha1.AvailabilityInstance{
+			ObjectReference: lssv1alpha1.ObjectReference{Name: "test", Namespace: "test"},
+		}
+	})
+
+	It("should start monitoring an instance with full availability", func() {
+		availabilityInstance.Status = string(lsv1alpha1.LsHealthCheckStatusOk)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, now)
+
+		Expect(availabilityInstance.MonitoredSince.Time).To(Equal(now))
+		Expect(availabilityInstance.Outages).To(BeEmpty())
+		Expect(availabilityInstance.Availability).To(Equal(&lssv1alpha1.Availability{
+			Day:   "100.000",
+			Week:  "100.000",
+			Month: "100.000",
+		}))
+	})
+
+	It("should open and close an outage", func() {
+		availabilityInstance.MonitoredSince = timeRef(now.Add(-healthwatcher.AvailabilityWindowMonth))
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, "landscaper down", true)
+		availabilityInstance.FailedSince = timeRef(now.Add(-time.Hour))
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, now)
+
+		Expect(availabilityInstance.Outages).To(HaveLen(1))
+		Expect(availabilityInstance.Outages[0].Start.Time).To(Equal(now.Add(-time.Hour)))
+		Expect(availabilityInstance.Outages[0].End).To(BeNil())
+		Expect(availabilityInstance.Outages[0].Reason).To(Equal("landscaper down"))
+
+		// the outage is still ongoing and is not opened twice
+		later := now.Add(5 * time.Hour)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, later)
+		Expect(availabilityInstance.Outages).To(HaveLen(1))
+
+		availabilityInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusOk, "", false)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, later)
+		Expect(availabilityInstance.Outages).To(HaveLen(1))
+		Expect(availabilityInstance.Outages[0].End.Time).To(Equal(later))
+
+		// 6 hours of 24 hours
+		Expect(availabilityInstance.Availability.Day).To(Equal("75.000"))
+		Expect(healthwatcher.ComputeAvailability(availabilityInstance, healthwatcher.AvailabilityWindowWeek, later)).
+			To(BeNumerically("~", 100*(1-6.0/(7*24)), 0.0001))
+	})
+
+	It("should not change the history of an instance with unknown status", func() {
+		availabilityInstance.MonitoredSince = timeRef(now.Add(-time.Hour))
+		availabilityInstance.Outages = []lssv1alpha1.Outage{{Start: metav1.NewTime(now.Add(-30 * time.Minute))}}
+		availabilityInstance.Status = string(lssv1alpha1.LsHealthCheckStatusUnknown)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, now)
+
+		Expect(availabilityInstance.Outages).To(HaveLen(1))
+		Expect(availabilityInstance.Outages[0].End).To(BeNil())
+		Expect(availabilityInstance.Availability.Day).To(Equal("50.000"))
+	})
+
+	It("should prune outages which ended before the history period", func() {
+		availabilityInstance.MonitoredSince = timeRef(now.Add(-60 * 24 * time.Hour))
+		availabilityInstance.Outages = []lssv1alpha1.Outage{
+			{Start: metav1.NewTime(now.Add(-40 * 24 * time.Hour)), End: timeRef(now.Add(-39 * 24 * time.Hour))},
+			{Start: metav1.NewTime(now.Add(-31 * 24 * time.Hour)), End: timeRef(now.Add(-29 * 24 * time.Hour))},
+		}
+		availabilityInstance.Status = string(lsv1alpha1.LsHealthCheckStatusOk)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, now)
+
+		Expect(availabilityInstance.Outages).To(HaveLen(1))
+		Expect(availabilityInstance.Outages[0].Start.Time).To(Equal(now.Add(-31 * 24 * time.Hour)))
+		// only the day within the window counts
+		Expect(availabilityInstance.Availability.Month).To(Equal("96.667"))
+		Expect(availabilityInstance.Availability.Week).To(Equal("100.000"))
+	})
+
+	It("should limit the number of outages", func() {
+		availabilityInstance.MonitoredSince = timeRef(now.Add(-healthwatcher.AvailabilityWindowDay))
+		for i := 0; i < healthwatcher.MaxOutages+10; i++ {
+			start := now.Add(-time.Duration(healthwatcher.MaxOutages+10-i) * time.Minute)
+			availabilityInstance.Outages = append(availabilityInstance.Outages, lssv1alpha1.Outage{
+				Start: metav1.NewTime(start),
+				End:   timeRef(start.Add(time.Second)),
+			})
+		}
+		availabilityInstance.Status = string(lsv1alpha1.LsHealthCheckStatusOk)
+		healthwatcher.UpdateAvailabilityHistory(availabilityInstance, now)
+
+		Expect(availabilityInstance.Outages).To(HaveLen(healthwatcher.MaxOutages))
+		Expect(availabilityInstance.Outages[0].Start.Time).To(Equal(now.Add(-time.Duration(healthwatcher.MaxOutages) * time.Minute)))
+	})
+
+	It("should compute the availability for the time an instance has been monitored", func() {
+		availabilityInstance.MonitoredSince = timeRef(now.Add(-2 * time.Hour))
+		availabilityInstance.Outages = []lssv1alpha1.Outage{
+			{Start: metav1.NewTime(now.Add(-3 * time.Hour)), End: timeRef(now.Add(-time.Hour))},
+		}
+
+		Expect(healthwatcher.ComputeAvailability(availabilityInstance, healthwatcher.AvailabilityWindowMonth, now)).To(Equal(50.0))
+		Expect(healthwatcher.ComputeAvailability(availabilityInstance, 30*time.Minute, now)).To(Equal(100.0))
+	})
+
+	It("should drop the oldest ended outages if the history exceeds its size", func() {
+		reason := strings.Repeat("x", 1000)
+		status := &lssv1alpha1.AvailabilityCollectionStatus{
+			Instances: []lssv1alpha1.AvailabilityInstance{
+				{
+					ObjectReference: lssv1alpha1.ObjectReference{Name: "instance1", Namespace: "test"},
+					Outages: []lssv1alpha1.Outage{
+						{Start: metav1.NewTime(now.Add(-4 * time.Hour)), End: timeRef(now.Add(-4 * time.Hour)), Reason: reason},
+						{Start: metav1.NewTime(now.Add(-2 * time.Hour)), End: timeRef(now.Add(-2 * time.Hour)), Reason: reason},
+					},
+				},
+				{
+					ObjectReference: lssv1alpha1.ObjectReference{Name: "instance2", Namespace: "test"},
+					Outages: []lssv1alpha1.Outage{
+						{Start: metav1.NewTime(now.Add(-5 * time.Hour)), Reason: reason},
+					},
+				},
+			},
+			Self: lssv1alpha1.AvailabilityInstance{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: "self", Namespace: "landscaper"},
+				Outages: []lssv1alpha1.Outage{
+					{Start: metav1.NewTime(now.Add(-3 * time.Hour)), End: timeRef(now.Add(-3 * time.Hour)), Reason: reason},
+				},
+			},
+		}
+
+		healthwatcher.LimitAvailabilityHistorySize(status, 100*1024)
+		Expect(status.Instances[0].Outages).To(HaveLen(2))
+		Expect(status.Self.Outages).To(HaveLen(1))
+
+		// two outages and the instances fit
+		healthwatcher.LimitAvailabilityHistorySize(status, 2700)
+		Expect(status.Instances[0].Outages).To(HaveLen(1))
+		Expect(status.Instances[0].Outages[0].Start.Time).To(Equal(now.Add(-2 * time.Hour)))
+		Expect(status.Self.Outages).To(BeEmpty())
+		Expect(status.Instances[1].Outages).To(HaveLen(1))
+
+		// the ongoing outage is kept
+		healthwatcher.LimitAvailabilityHistorySize(status, 0)
+		Expect(status.Instances[0].Outages).To(BeEmpty())
+		Expect(status.Instances[1].Outages).To(HaveLen(1))
+	})
+})
@@ -35,6 +35,30 @@ func (e *TestServiceTargetKubeClientExtractor) GetKubeClientFromServiceTargetCon
 	return client, nil
 }
 
+// BlockingServiceTargetKubeClientExtractor fakes a hanging target cluster, it blocks until it is released.
+type BlockingServiceTargetKubeClientExtractor struct {
+	release chan struct{}
+}
+
+func (e *BlockingServiceTargetKubeClientExtractor) GetKubeClientFromServiceTargetConfig(ctx context.Context, name string, namespace string, client client.Client) (client.Client, error) {
+	<-e.release
+	return client, nil
+}
+
+// FailingServiceTargetKubeClientExtractor fakes a target cluster whose client can't be created.
+type FailingServiceTargetKubeClientExtractor struct{}
+
+func (e *FailingServiceTargetKubeClientExtractor) GetKubeClientFromServiceTargetConfig(ctx context.Context, name string, namespace string, client client.Client) (client.Client, error) {
+	return nil, fmt.Errorf("invalid kubeconfig")
+}
+
+// FailingDataPlaneProber fakes an unavailable external data plane cluster.
+type FailingDataPlaneProber struct{}
+
+func (p *FailingDataPlaneProber) ProbeDataPlane(ctx context.Context, instance *lssv1alpha1.Instance, client client.Client) error {
+	return fmt.Errorf("api server not reachable")
+}
+
 var _ = Describe("Reconcile", func() {
 	var (
 		op    *operation.Operation
@@ -219,6 +243,132 @@ var _ = Describe("Reconcile", func() {
 		Expect(availabilityCollection.Status.Instances[1].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
 		Expect(availabilityCollection.Status.Instances[0].FailedSince).To(BeNil())
 		Expect(availabilityCollection.Status.Instances[1].FailedSince).To(BeNil())
+		Expect(availabilityCollection.Status.Instances[0].Identity).ToNot(BeNil())
+		Expect(availabilityCollection.Status.Instances[0].Identity.TenantId).To(Equal("12345"))
+		Expect(availabilityCollection.Status.Instances[0].Identity.InstanceId).To(Equal("aabbccdd"))
+	})
+
+	It("should keep the status and history of an instance whose installation is progressing", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+
+		lshealthcheck1 := state.GetLsHealthCheckInNamespace("default", fmt.Sprintf("instance1namespace-%s", state.Namespace))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(lshealthcheck1), lshealthcheck1)).To(Succeed())
+		lshealthcheck1.Status = lsv1alpha1.LsHealthCheckStatusFailed
+		lshealthcheck1.LastUpdateTime = v1.Time{Time: v1.Now().Add(time.Minute * -6)}
+		Expect(testenv.Client.Update(ctx, lshealthcheck1)).To(Succeed())
+
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		previous := availabilityCollection.Status.Instances[0].DeepCopy()
+		Expect(previous.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+		Expect(previous.MonitoredSince).ToNot(BeNil())
+		Expect(previous.Outages).To(HaveLen(1))
+
+		installation1 := state.GetInstallation("installation1")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(installation1), installation1)).To(Succeed())
+		installation1.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Progressing
+		Expect(testenv.Client.Status().Update(ctx, installation1)).To(Succeed())
+
+		// force the next run
+		availabilityCollection.Status.LastRun = v1.Time{Time: v1.Now().Add(-time.Hour)}
+		Expect(testenv.Client.Status().Update(ctx, availabilityCollection)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Instances[0].Name).To(Equal("instance1"))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(previous.Status))
+		Expect(availabilityCollection.Status.Instances[0].MonitoredSince.Equal(previous.MonitoredSince)).To(BeTrue())
+		Expect(availabilityCollection.Status.Instances[0].Outages).To(HaveLen(1))
+		Expect(availabilityCollection.Status.Instances[0].Outages[0].End).To(BeNil())
+	})
+
+	It("should report an instance with an unavailable external data plane cluster as failed", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+		ctrl = healthwatcher.NewTestActuator(*op, &TestServiceTargetKubeClientExtractor{}, logging.Discard()).
+			WithDataPlaneProber(&FailingDataPlaneProber{})
+
+		instance1 := state.GetInstance("instance1")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance1), instance1)).To(Succeed())
+		instance1.Spec.DataPlane = &lssv1alpha1.DataPlane{Kubeconfig: "dummy"}
+		Expect(testenv.Client.Update(ctx, instance1)).To(Succeed())
+
+		for _, namespace := range []string{"instance1namespace", "instance2namespace"} {
+			lshealthcheck := state.GetLsHealthCheckInNamespace("default", fmt.Sprintf("%s-%s", namespace, state.Namespace))
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(lshealthcheck), lshealthcheck)).To(Succeed())
+			lshealthcheck.LastUpdateTime = v1.Now()
+			Expect(testenv.Client.Update(ctx, lshealthcheck)).To(Succeed())
+		}
+
+		// the instance is not reported as failed before the timeout
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(availabilityCollection.Status.Instances[0].FailedReason).To(ContainSubstring("data plane cluster: failed - waiting for timeout"))
+		Expect(availabilityCollection.Status.Instances[0].DataPlaneFailedSince).ToNot(BeNil())
+		Expect(availabilityCollection.Status.Instances[0].FailureType).To(BeEmpty())
+
+		// force the next run after the timeout
+		failedSince := v1.Time{Time: v1.Now().Add(-time.Hour)}
+		availabilityCollection.Status.Instances[0].DataPlaneFailedSince = &failedSince
+		availabilityCollection.Status.LastRun = v1.Time{Time: v1.Now().Add(-time.Hour)}
+		Expect(testenv.Client.Status().Update(ctx, availabilityCollection)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+		Expect(availabilityCollection.Status.Instances[0].FailedReason).To(Equal("data plane cluster: api server not reachable"))
+		Expect(availabilityCollection.Status.Instances[0].FailureType).To(Equal(lssv1alpha1.AvailabilityFailureTypeDataPlane))
+		Expect(availabilityCollection.Status.Instances[1].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(availabilityCollection.Status.Instances[1].FailureType).To(BeEmpty())
+	})
+
+	It("should probe the external data plane cluster even if the landscaper can't be checked", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+		ctrl = healthwatcher.NewTestActuator(*op, &FailingServiceTargetKubeClientExtractor{}, logging.Discard()).
+			WithDataPlaneProber(&FailingDataPlaneProber{})
+
+		instance1 := state.GetInstance("instance1")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance1), instance1)).To(Succeed())
+		instance1.Spec.DataPlane = &lssv1alpha1.DataPlane{Kubeconfig: "dummy"}
+		Expect(testenv.Client.Update(ctx, instance1)).To(Succeed())
+
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		failedSince := v1.Time{Time: v1.Now().Add(-time.Hour)}
+		availabilityCollection.Status.Instances = []lssv1alpha1.AvailabilityInstance{
+			{
+				ObjectReference:      lssv1alpha1.ObjectReference{Name: instance1.Name, Namespace: instance1.Namespace},
+				Status:               string(lsv1alpha1.LsHealthCheckStatusOk),
+				DataPlaneFailedSince: &failedSince,
+			},
+		}
+		Expect(testenv.Client.Status().Update(ctx, availabilityCollection)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+		Expect(availabilityCollection.Status.Instances[0].FailedReason).To(Equal("data plane cluster: api server not reachable"))
+		Expect(availabilityCollection.Status.Instances[0].FailureType).To(Equal(lssv1alpha1.AvailabilityFailureTypeDataPlane))
+		Expect(availabilityCollection.Status.Instances[1].FailedReason).To(Equal("could not create k8s client from target config"))
+		Expect(availabilityCollection.Status.Instances[1].FailureType).To(Equal(lssv1alpha1.AvailabilityFailureTypeLandscaper))
 	})
 
 	It("should collect lshealthcheck from one successful and one failed (and timeouted) instances", func() {
@@ -258,6 +408,44 @@ var _ = Describe("Reconcile", func() {
 		Expect(availabilityCollection.Status.Instances[1].FailedSince).ToNot(BeNil())
 	})
 
+	It("should report a hibernated instance as hibernated instead of failed", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+
+		//set lastUpdateTime of LsHealthCheck to recent
+		lsHealthObject := state.GetLsHealthCheck("default")
+		lsHealthObject.LastUpdateTime = v1.Now()
+		Expect(testenv.Client.Update(ctx, lsHealthObject)).To(Succeed())
+
+		lshealthcheck1 := state.GetLsHealthCheckInNamespace("default", fmt.Sprintf("instance1namespace-%s", state.Namespace))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(lshealthcheck1), lshealthcheck1)).To(Succeed())
+		lshealthcheck1.LastUpdateTime = v1.Now()
+		Expect(testenv.Client.Update(ctx, lshealthcheck1)).To(Succeed())
+
+		//the lshealthcheck of the hibernated instance is not updated anymore
+		lshealthcheck2 := state.GetLsHealthCheckInNamespace("default", fmt.Sprintf("instance2namespace-%s", state.Namespace))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(lshealthcheck2), lshealthcheck2)).To(Succeed())
+		lshealthcheck2.LastUpdateTime = v1.Time{Time: v1.Now().Add(time.Hour * -6)}
+		Expect(testenv.Client.Update(ctx, lshealthcheck2)).To(Succeed())
+
+		instance2 := state.GetInstance("instance2")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance2), instance2)).To(Succeed())
+		instance2.Status.Hibernation = &lssv1alpha1.HibernationStatus{Hibernated: true}
+		Expect(testenv.Client.Status().Update(ctx, instance2)).To(Succeed())
+
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(len(availabilityCollection.Status.Instances)).To(Equal(2))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(availabilityCollection.Status.Instances[1].Status).To(Equal(string(lssv1alpha1.LsHealthCheckStatusHibernated)))
+		Expect(availabilityCollection.Status.Instances[1].FailedReason).To(BeEmpty())
+		Expect(availabilityCollection.Status.Instances[1].FailedSince).To(BeNil())
+	})
+
 	It("should collect lshealthcheck from 2 successful but one timeouted instance", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
@@ -346,6 +534,60 @@ var _ = Describe("Reconcile", func() {
 		Expect(availabilityCollection.Status.Instances[1].FailedSince).ToNot(BeNil())
 	})
 
+	It("should skip deleted instances and still check the other instances", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+
+		for _, namespace := range []string{"instance1namespace", "instance2namespace"} {
+			lshealthcheck := state.GetLsHealthCheckInNamespace("default", fmt.Sprintf("%s-%s", namespace, state.Namespace))
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(lshealthcheck), lshealthcheck)).To(Succeed())
+			lshealthcheck.LastUpdateTime = v1.Now()
+			Expect(testenv.Client.Update(ctx, lshealthcheck)).To(Succeed())
+		}
+
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		availabilityCollection.Spec.InstanceRefs = append([]lssv1alpha1.ObjectReference{{Name: "deleted", Namespace: state.Namespace}},
+			availabilityCollection.Spec.InstanceRefs...)
+		Expect(testenv.Client.Update(ctx, availabilityCollection)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.ObservedGeneration).To(Equal(availabilityCollection.Generation))
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		Expect(availabilityCollection.Status.Instances[0].Name).To(Equal("instance1"))
+		Expect(availabilityCollection.Status.Instances[0].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(availabilityCollection.Status.Instances[1].Name).To(Equal("instance2"))
+		Expect(availabilityCollection.Status.Instances[1].Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+	})
+
+	It("should report instances whose health check times out as failed", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.SelfLandscaperNamespace = state.Namespace
+		op.Config().AvailabilityMonitoring.CheckConcurrency = 1
+		op.Config().AvailabilityMonitoring.InstanceCheckTimeout = lsv1alpha1.Duration{Duration: 100 * time.Millisecond}
+
+		extractor := &BlockingServiceTargetKubeClientExtractor{release: make(chan struct{})}
+		DeferCleanup(func() { close(extractor.release) })
+		ctrl = healthwatcher.NewTestActuator(*op, extractor, logging.Discard())
+
+		availabilityCollection := state.GetAvailabilityCollection("availability3")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(availabilityCollection))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(availabilityCollection), availabilityCollection)).To(Succeed())
+		Expect(availabilityCollection.Status.Instances).To(HaveLen(2))
+		for _, avInstance := range availabilityCollection.Status.Instances {
+			Expect(avInstance.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+			Expect(avInstance.FailedReason).To(Equal("timeout - health check did not finish within 100ms"))
+			Expect(avInstance.FailureType).To(Equal(lssv1alpha1.AvailabilityFailureTypeLandscaper))
+			Expect(avInstance.FailedSince).ToNot(BeNil())
+		}
+	})
 })
 var _ = Describe("failed/succeded state handling", func() {
 
@@ -437,4 +679,49 @@ var _ = Describe("failed/succeded state handling", func() {
 		Expect(avInstance.FailedReason).To(ContainSubstring("instance failed recovering from failed state within time"))
 		Expect(avInstance.FailedSince).ToNot(BeNil())
 	})
+
+	It("should keep the status while the data plane cluster is failing shorter than the timeout", func() {
+		avInstance := &lssv1alpha1.AvailabilityInstance{}
+		avInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusOk, "", false)
+		timeout := time.Minute * 5
+		healthwatcher.TransferDataPlaneStatusToAvailabilityInstance(avInstance, fmt.Errorf("api server not reachable"), timeout)
+		Expect(avInstance.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(avInstance.FailedReason).To(Equal("data plane cluster: failed - waiting for timeout (5m0s) to transition to status=Failed: api server not reachable"))
+		Expect(avInstance.FailedSince).To(BeNil())
+		Expect(avInstance.DataPlaneFailedSince).ToNot(BeNil())
+		Expect(avInstance.FailureType).To(BeEmpty())
+	})
+
+	It("should set status to failed if the data plane cluster is failing longer than the timeout", func() {
+		since := v1.Time{Time: v1.Now().Add(time.Minute * -6)}
+		avInstance := &lssv1alpha1.AvailabilityInstance{DataPlaneFailedSince: &since}
+		avInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusOk, "", false)
+		timeout := time.Minute * 5
+		healthwatcher.TransferDataPlaneStatusToAvailabilityInstance(avInstance, fmt.Errorf("api server not reachable"), timeout)
+		Expect(avInstance.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+		Expect(avInstance.FailedReason).To(Equal("data plane cluster: api server not reachable"))
+		Expect(avInstance.FailedSince).ToNot(BeNil())
+		Expect(avInstance.DataPlaneFailedSince.Equal(&since)).To(BeTrue())
+		Expect(avInstance.FailureType).To(Equal(lssv1alpha1.AvailabilityFailureTypeDataPlane))
+	})
+
+	It("should not override a failed landscaper while the data plane cluster is failing shorter than the timeout", func() {
+		avInstance := &lssv1alpha1.AvailabilityInstance{}
+		avInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusFailed, "failed retrieving lshealthcheck cr", true)
+		timeout := time.Minute * 5
+		healthwatcher.TransferDataPlaneStatusToAvailabilityInstance(avInstance, fmt.Errorf("api server not reachable"), timeout)
+		Expect(avInstance.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusFailed)))
+		Expect(avInstance.FailedReason).To(Equal("failed retrieving lshealthcheck cr"))
+		Expect(avInstance.DataPlaneFailedSince).ToNot(BeNil())
+	})
+
+	It("should reset the data plane failure if the probe succeeds", func() {
+		since := v1.Time{Time: v1.Now().Add(time.Minute * -6)}
+		avInstance := &lssv1alpha1.AvailabilityInstance{DataPlaneFailedSince: &since}
+		avInstance.SetStatusAndFailedSince(lsv1alpha1.LsHealthCheckStatusOk, "", false)
+		healthwatcher.TransferDataPlaneStatusToAvailabilityInstance(avInstance, nil, time.Minute*5)
+		Expect(avInstance.Status).To(Equal(string(lsv1alpha1.LsHealthCheckStatusOk)))
+		Expect(avInstance.FailedReason).To(BeEmpty())
+		Expect(avInstance.DataPlaneFailedSince).To(BeNil())
+	})
 })
@@ -5,6 +5,8 @@
 package instances
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -16,16 +18,21 @@ import (
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 )
 
 // AddControllerToManager adds the instances controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("instance", "Instance")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("instance-controller"), config)
 	if err != nil {
 		return err
 	}
 
+	if err := metrics.RegisterInstanceCollector(mgr.GetClient()); err != nil {
+		return fmt.Errorf("unable to register instance metrics: %w", err)
+	}
+
 	return builder.ControllerManagedBy(mgr).
 		Named("instance-controller").
 		For(&lssv1alpha1.Instance{}).
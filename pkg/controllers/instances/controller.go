@@ -13,9 +13,11 @@ import (
 
 	guuid "github.com/google/uuid"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -74,7 +76,7 @@ func defaultUniqueIdFunc() string {
 }
 
 // NewController returns a new instances controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log:                 logger,
 		UniqueIDFunc:        defaultUniqueIdFunc,
@@ -83,7 +85,7 @@ func NewController(logger logging.Logger, c client.Client, scheme *runtime.Schem
 	ctrl.ReconcileFunc = ctrl.reconcile
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
 	ctrl.ListShootsFunc = ctrl.listShoots
-	op := operation.NewOperation(c, scheme, config)
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
@@ -153,6 +155,12 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return computeAutomaticReconcile(instance, nil)
 	}
 
+	// migrate to a different service target config
+	if isMigrationPending(instance) {
+		result, err := c.handleMigration(ctx, instance)
+		return result, errHdl(ctx, err)
+	}
+
 	// reconcile
 	return computeAutomaticReconcile(instance, errHdl(ctx, c.ReconcileFunc(ctx, instance)))
 }
@@ -163,6 +171,15 @@ func (c *Controller) handleErrorFunc(instance *lssv1alpha1.Instance) func(ctx co
 	return func(ctx context.Context, err error) error {
 		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()})
 		instance.Status.LastError = lsserrors.TryUpdateError(instance.Status.LastError, err)
+		if err != nil {
+			lsserrors.SetErrorCondition(&instance.Status.Conditions, lssv1alpha1.ConditionTypeReady, err, instance.GetGeneration())
+
+			reason := operation.EventReasonReconcileFailed
+			if !instance.DeletionTimestamp.IsZero() {
+				reason = operation.EventReasonDeletionFailed
+			}
+			c.EventRecorder().Event(instance, corev1.EventTypeWarning, reason, err.Error())
+		}
 
 		if !reflect.DeepEqual(old.Status, instance.Status) {
 			if err2 := c.Client().Status().Update(ctx, instance); err2 != nil {
@@ -189,6 +206,20 @@ func computeAutomaticReconcile(instance *lssv1alpha1.Instance, reconcileError er
 		reconcileInterval = instance.Spec.AutomaticReconcile.Interval.Duration
 	}
 
+	// held changes are applied when the next maintenance time window begins
+	if maintenance := instance.Status.Maintenance; maintenance != nil && maintenance.NextMaintenanceTime != nil {
+		if untilMaintenance := time.Until(maintenance.NextMaintenanceTime.Time); untilMaintenance < reconcileInterval {
+			reconcileInterval = max(untilMaintenance, time.Second)
+		}
+	}
+
+	// the hibernation state changes when the next hibernation schedule starts or ends
+	if hibernation := instance.Status.Hibernation; hibernation != nil && hibernation.NextTransitionTime != nil {
+		if untilTransition := time.Until(hibernation.NextTransitionTime.Time); untilTransition < reconcileInterval {
+			reconcileInterval = max(untilTransition, time.Second)
+		}
+	}
+
 	if reconcileError == nil {
 		return reconcile.Result{
 			Requeue:      true,
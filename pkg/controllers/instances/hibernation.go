@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// hibernationScheduleLookback is the period for which past starts and ends of hibernation schedules are evaluated.
+	// Schedules which neither started nor ended during this period don't hibernate the instance.
+	hibernationScheduleLookback = 8 * 24 * time.Hour
+
+	// hibernatedReplicasAnnotation stores the number of replicas of a landscaper deployment before it has been scaled down.
+	hibernatedReplicasAnnotation = "landscaper-service.gardener.cloud/hibernated-replicas"
+)
+
+// hibernationSchedule is a parsed hibernation schedule.
+type hibernationSchedule struct {
+	start cron.Schedule
+	end   cron.Schedule
+}
+
+// parseHibernationSchedule parses the start and end cron expressions of a hibernation schedule in its time zone.
+func parseHibernationSchedule(schedule *lssv1alpha1.HibernationSchedule) (*hibernationSchedule, error) {
+	location := "UTC"
+	if schedule.Location != nil {
+		location = *schedule.Location
+	}
+
+	start, err := cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", location, schedule.Start))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hibernation schedule start %q: %w", schedule.Start, err)
+	}
+	end, err := cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", location, schedule.End))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hibernation schedule end %q: %w", schedule.End, err)
+	}
+
+	return &hibernationSchedule{
+		start: start,
+		end:   end,
+	}, nil
+}
+
+// lastActivation returns the last time before the given time at which the cron schedule has been activated.
+func lastActivation(schedule cron.Schedule, t time.Time) (time.Time, bool) {
+	var last time.Time
+	for next := schedule.Next(t.Add(-hibernationScheduleLookback)); !next.IsZero() && !next.After(t); next = schedule.Next(next) {
+		last = next
+	}
+	return last, !last.IsZero()
+}
+
+// IsHibernated tests whether the hibernation schedule has been started more recently than it has been ended.
+func (s *hibernationSchedule) IsHibernated(t time.Time) bool {
+	lastStart, ok := lastActivation(s.start, t)
+	if !ok {
+		return false
+	}
+	lastEnd, ok := lastActivation(s.end, t)
+	return !ok || lastStart.After(lastEnd)
+}
+
+// NextTransition returns the next time after the given time at which the hibernation schedule starts or ends.
+func (s *hibernationSchedule) NextTransition(t time.Time) time.Time {
+	nextStart := s.start.Next(t)
+	nextEnd := s.end.Next(t)
+	if nextStart.IsZero() || (!nextEnd.IsZero() && nextEnd.Before(nextStart)) {
+		return nextEnd
+	}
+	return nextStart
+}
+
+// computeHibernationStatus computes whether the instance is hibernated at the given time.
+// Nil is returned when the instance has no hibernation configured.
+func computeHibernationStatus(instance *lssv1alpha1.Instance, t time.Time) (*lssv1alpha1.HibernationStatus, error) {
+	hibernation := instance.Spec.Hibernation
+	if hibernation == nil || (hibernation.Enabled == nil && len(hibernation.Schedules) == 0) {
+		return nil, nil
+	}
+
+	if hibernation.Enabled != nil {
+		return &lssv1alpha1.HibernationStatus{
+			Hibernated: *hibernation.Enabled,
+		}, nil
+	}
+
+	status := &lssv1alpha1.HibernationStatus{}
+	var nextTransition time.Time
+
+	for i := range hibernation.Schedules {
+		schedule, err := parseHibernationSchedule(&hibernation.Schedules[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if schedule.IsHibernated(t) {
+			status.Hibernated = true
+		}
+
+		if next := schedule.NextTransition(t); !next.IsZero() && (nextTransition.IsZero() || next.Before(nextTransition)) {
+			nextTransition = next
+		}
+	}
+
+	if !nextTransition.IsZero() {
+		status.NextTransitionTime = &metav1.Time{Time: nextTransition}
+	}
+
+	return status, nil
+}
+
+// isHibernated tests whether the instance status reports the instance as hibernated.
+func isHibernated(instance *lssv1alpha1.Instance) bool {
+	return instance.Status.Hibernation != nil && instance.Status.Hibernation.Hibernated
+}
+
+// reconcileHibernation scales the landscaper deployments of the instance on the hosting cluster.
+// The deployments are scaled down when the instance is hibernated and the installation has reached a final phase,
+// so that a reconcile of the installation doesn't scale them up again.
+// When the instance is woken up, the deployments are scaled to their replicas before the hibernation.
+func (c *Controller) reconcileHibernation(ctx context.Context, instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "reconcileHibernation")
+
+	hibernated := isHibernated(instance)
+	if hibernated && !installation.Status.InstallationPhase.IsFinal() {
+		logger.Info("installation is not in a final phase, postpone scaling down landscaper deployments", "phase", installation.Status.InstallationPhase)
+		return nil
+	}
+
+	hostingClusterClient, err := c.kubeClientExtractor.GetKubeClientFromServiceTargetConfig(
+		ctx,
+		instance.Spec.ServiceTargetConfigRef.Name,
+		instance.Spec.ServiceTargetConfigRef.Namespace,
+		c.Client())
+	if err != nil {
+		return fmt.Errorf("failed to get client for hosting cluster: %w", err)
+	}
+
+	hostingClusterNamespace := fmt.Sprintf("%s-%s", instance.Spec.TenantId, instance.Spec.ID)
+
+	deployments := &appsv1.DeploymentList{}
+	if err := hostingClusterClient.List(ctx, deployments, client.InNamespace(hostingClusterNamespace)); err != nil {
+		return fmt.Errorf("unable to list landscaper deployments in namespace %q: %w", hostingClusterNamespace, err)
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		replicasBefore, isScaledDown := deployment.Annotations[hibernatedReplicasAnnotation]
+		if (hibernated && ptr.Deref(deployment.Spec.Replicas, 1) == 0) || (!hibernated && !isScaledDown) {
+			continue
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+
+		if hibernated {
+			logger.Info("scaling down landscaper deployment", lc.KeyResource, client.ObjectKeyFromObject(deployment).String())
+			if !isScaledDown {
+				metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, hibernatedReplicasAnnotation, strconv.Itoa(int(ptr.Deref(deployment.Spec.Replicas, 1))))
+			}
+			deployment.Spec.Replicas = ptr.To[int32](0)
+		} else {
+			replicas, err := strconv.ParseInt(replicasBefore, 10, 32)
+			if err != nil {
+				replicas = 1
+			}
+			logger.Info("scaling up landscaper deployment", lc.KeyResource, client.ObjectKeyFromObject(deployment).String(), "replicas", replicas)
+			delete(deployment.Annotations, hibernatedReplicasAnnotation)
+			deployment.Spec.Replicas = ptr.To(int32(replicas))
+		}
+
+		if err := hostingClusterClient.Patch(ctx, deployment, patch); err != nil {
+			return fmt.Errorf("unable to scale landscaper deployment %q: %w", client.ObjectKeyFromObject(deployment).String(), err)
+		}
+	}
+
+	return nil
+}
@@ -19,21 +19,8 @@ func InstallationSpecDeepEquals(specA, specB *lsv1alpha1.InstallationSpec) bool
 	}
 
 	for k, v := range specA.ImportDataMappings {
-		if _, ok := specB.ImportDataMappings[k]; !ok {
-			return false
-		}
-
-		var importDataMappingA interface{}
-		if err := json.Unmarshal(v.RawMessage, &importDataMappingA); err != nil {
-			return false
-		}
-
-		var importDataMappingB interface{}
-		if err := json.Unmarshal(specB.ImportDataMappings[k].RawMessage, &importDataMappingB); err != nil {
-			return false
-		}
-
-		if !reflect.DeepEqual(importDataMappingA, importDataMappingB) {
+		vB, ok := specB.ImportDataMappings[k]
+		if !ok || !importDataMappingEquals(v, vB) {
 			return false
 		}
 	}
@@ -43,3 +30,18 @@ func InstallationSpecDeepEquals(specA, specB *lsv1alpha1.InstallationSpec) bool
 
 	return reflect.DeepEqual(specA, specB)
 }
+
+// importDataMappingEquals tests whether two import data mappings contain the same json value.
+func importDataMappingEquals(a, b lsv1alpha1.AnyJSON) bool {
+	var valueA interface{}
+	if err := json.Unmarshal(a.RawMessage, &valueA); err != nil {
+		return false
+	}
+
+	var valueB interface{}
+	if err := json.Unmarshal(b.RawMessage, &valueB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(valueA, valueB)
+}
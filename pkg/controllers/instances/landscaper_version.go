@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
+)
+
+// getSupportedVersions returns the supported landscaper service component versions of the configuration.
+func (c *Controller) getSupportedVersions() (versions.SupportedVersions, error) {
+	supportedVersions, err := versions.ParseSupportedVersions(c.Config().LandscaperServiceComponent.GetSupportedVersions())
+	if err != nil {
+		return nil, fmt.Errorf("invalid supported versions: %w", err)
+	}
+	if len(supportedVersions) == 0 {
+		return nil, fmt.Errorf("no supported version is configured")
+	}
+	return supportedVersions, nil
+}
+
+// getLandscaperServiceComponent returns the landscaper service component which is installed for the given instance.
+// An instance without landscaper version gets the default version of the configuration or, if not configured,
+// the latest supported version. Otherwise, the requested version is resolved to the supported patch version of
+// its minor version, or to the next supported minor version if the requested minor version is no longer supported.
+func (c *Controller) getLandscaperServiceComponent(instance *lssv1alpha1.Instance) (*lssv1alpha1.LandscaperServiceComponent, error) {
+	componentConfig := &c.Config().LandscaperServiceComponent
+
+	supportedVersions, err := c.getSupportedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var version *semver.Version
+	switch {
+	case len(instance.Spec.LandscaperVersion) != 0:
+		requested, err := versions.ParseVersion(instance.Spec.LandscaperVersion)
+		if err != nil {
+			return nil, err
+		}
+		if version, err = supportedVersions.Resolve(requested); err != nil {
+			return nil, err
+		}
+	case len(componentConfig.Version) != 0:
+		if version, err = versions.ParseVersion(componentConfig.Version); err != nil {
+			return nil, err
+		}
+	default:
+		version = supportedVersions.Latest()
+	}
+
+	return c.newLandscaperServiceComponent(version, supportedVersions), nil
+}
+
+// getLandscaperServiceComponentForVersion returns the landscaper service component with the given version.
+func (c *Controller) getLandscaperServiceComponentForVersion(version string) (*lssv1alpha1.LandscaperServiceComponent, error) {
+	supportedVersions, err := c.getSupportedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := versions.ParseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newLandscaperServiceComponent(v, supportedVersions), nil
+}
+
+// newLandscaperServiceComponent returns the landscaper service component with the given version,
+// including whether the version is deprecated and when it will be removed.
+func (c *Controller) newLandscaperServiceComponent(version *semver.Version, supportedVersions versions.SupportedVersions) *lssv1alpha1.LandscaperServiceComponent {
+	componentConfig := &c.Config().LandscaperServiceComponent
+
+	component := &lssv1alpha1.LandscaperServiceComponent{
+		Name:       componentConfig.Name,
+		Version:    version.Original(),
+		Deprecated: supportedVersions.IsDeprecated(version),
+	}
+
+	if deprecatedSince := componentConfig.GetDeprecatedSince(component.Version); deprecatedSince != nil {
+		component.Deprecated = true
+		component.RemovalTime = &metav1.Time{Time: deprecatedSince.Add(componentConfig.DeprecationPeriod.Duration)}
+	}
+
+	return component
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsinstallation "github.com/gardener/landscaper-service/pkg/apis/installation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// getMaintenanceTimeWindow returns the maintenance time window of the instance, see utils.GetMaintenanceTimeWindow.
+func (c *Controller) getMaintenanceTimeWindow(instance *lssv1alpha1.Instance) (*utils.MaintenanceTimeWindow, error) {
+	return utils.GetMaintenanceTimeWindow(instance, c.Config().ShootConfiguration.Maintenance.TimeWindow)
+}
+
+// holdInstallationChanges reverts changes of the component version and of the landscaper configuration
+// of an existing installation when the current time is outside the maintenance time window of the instance.
+// The held changes and the next maintenance time are written into the instance status.
+func (c *Controller) holdInstallationChanges(instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation, oldInstallationSpec *lsv1alpha1.InstallationSpec) error {
+	instance.Status.Maintenance = nil
+
+	if installation.CreationTimestamp.IsZero() {
+		// a new installation is created immediately
+		return nil
+	}
+
+	timeWindow, err := c.getMaintenanceTimeWindow(instance)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if timeWindow == nil || timeWindow.Contains(now) {
+		return nil
+	}
+
+	pendingChanges := make([]string, 0, 2)
+
+	if oldInstallationSpec.ComponentDescriptor != nil && oldInstallationSpec.ComponentDescriptor.Reference != nil {
+		oldReference := oldInstallationSpec.ComponentDescriptor.Reference
+		newReference := installation.Spec.ComponentDescriptor.Reference
+		if oldReference.Version != newReference.Version {
+			pendingChanges = append(pendingChanges, fmt.Sprintf("landscaper version %s -> %s", oldReference.Version, newReference.Version))
+			newReference.Version = oldReference.Version
+		}
+	}
+
+	oldLandscaperConfig, ok := oldInstallationSpec.ImportDataMappings[lsinstallation.LandscaperConfigImportName]
+	if ok && !importDataMappingEquals(oldLandscaperConfig, installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName]) {
+		pendingChanges = append(pendingChanges, "landscaper configuration")
+		installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName] = oldLandscaperConfig
+	}
+
+	if len(pendingChanges) > 0 {
+		instance.Status.Maintenance = &lssv1alpha1.MaintenanceStatus{
+			PendingChanges:      pendingChanges,
+			NextMaintenanceTime: &metav1.Time{Time: timeWindow.NextBegin(now)},
+		}
+	}
+
+	return nil
+}
@@ -5,7 +5,9 @@
 package instances
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -19,6 +21,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	cdv2 "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,6 +35,8 @@ import (
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/errors"
 	lsinstallation "github.com/gardener/landscaper-service/pkg/apis/installation"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+	"github.com/gardener/landscaper-service/pkg/operation"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
@@ -60,39 +65,110 @@ const (
 	// This kubeconfig is used to deploy RBAC objects on the resource cluster. Maximum: 86400 (1 day).
 	// Each reconcile uses a new kubeconfig, so that the short duration suffices.
 	adminKubeconfigExpirationSeconds = int64(24 * 60 * 60)
+
+	// kubeconfigSecretKey is the key of the kubeconfig in the kubeconfig secrets of an instance.
+	kubeconfigSecretKey = "kubeconfig"
+	// kubeconfigTypeUser is the kubeconfig type label value of the user kubeconfig secret.
+	kubeconfigTypeUser = "user"
+	// kubeconfigTypeAdmin is the kubeconfig type label value of the admin kubeconfig secret.
+	kubeconfigTypeAdmin = "admin"
 )
 
 // reconcile reconciles an instance.
 func (c *Controller) reconcile(ctx context.Context, instance *lssv1alpha1.Instance) error {
 	currOp := "Reconcile"
+	conditions := &instance.Status.Conditions
+	generation := instance.GetGeneration()
 
-	if err := c.reconcileContext(ctx, instance); err != nil {
-		return errors.NewWrappedError(err, currOp, "ReconcileContextFailed", err.Error())
+	start := time.Now()
+	err := c.reconcileContext(ctx, instance)
+	metrics.ObserveInstanceReconcileStep(metrics.InstanceStepContext, start, err)
+	if err != nil {
+		err := errors.NewWrappedError(err, currOp, "ReconcileContextFailed", err.Error())
+		errors.SetErrorCondition(conditions, lssv1alpha1.ConditionTypeContextReady, err, generation)
+		return err
 	}
+	errors.SetCondition(conditions, lssv1alpha1.ConditionTypeContextReady, metav1.ConditionTrue, "ContextReconciled", "", generation)
 
+	start = time.Now()
 	if instance.IsInternalDataPlane() {
 		if err := c.reconcileGardenerServiceAccountTarget(ctx, instance); err != nil {
-			return errors.NewWrappedError(err, currOp, "ReconcileGardenerServiceAccountTargetFailed", err.Error())
+			metrics.ObserveInstanceReconcileStep(metrics.InstanceStepTarget, start, err)
+			err := errors.NewWrappedError(err, currOp, "ReconcileGardenerServiceAccountTargetFailed", err.Error())
+			errors.SetErrorCondition(conditions, lssv1alpha1.ConditionTypeTargetReady, err, generation)
+			return err
 		}
 	}
 
 	if instance.IsExternalDataPlane() {
 		if err := c.reconcileExternalDataPlaneClusterTarget(ctx, instance); err != nil {
-			return errors.NewWrappedError(err, currOp, "ReconcileExternalDataPlaneClusterTarget", err.Error())
+			metrics.ObserveInstanceReconcileStep(metrics.InstanceStepTarget, start, err)
+			err := errors.NewWrappedError(err, currOp, "ReconcileExternalDataPlaneClusterTarget", err.Error())
+			errors.SetErrorCondition(conditions, lssv1alpha1.ConditionTypeTargetReady, err, generation)
+			return err
 		}
 	}
 
-	if err := c.reconcileTarget(ctx, instance); err != nil {
-		return errors.NewWrappedError(err, currOp, "ReconcileTargetFailed", err.Error())
+	err = c.reconcileTarget(ctx, instance)
+	metrics.ObserveInstanceReconcileStep(metrics.InstanceStepTarget, start, err)
+	if err != nil {
+		err := errors.NewWrappedError(err, currOp, "ReconcileTargetFailed", err.Error())
+		errors.SetErrorCondition(conditions, lssv1alpha1.ConditionTypeTargetReady, err, generation)
+		return err
 	}
+	errors.SetCondition(conditions, lssv1alpha1.ConditionTypeTargetReady, metav1.ConditionTrue, "TargetReconciled", "", generation)
 
-	if err := c.reconcileInstallation(ctx, instance); err != nil {
-		return errors.NewWrappedError(err, currOp, "ReconcileInstallationFailed", err.Error())
+	start = time.Now()
+	err = c.reconcileInstallation(ctx, instance)
+	metrics.ObserveInstanceReconcileStep(metrics.InstanceStepInstallation, start, err)
+	if err != nil {
+		err := errors.NewWrappedError(err, currOp, "ReconcileInstallationFailed", err.Error())
+		errors.SetErrorCondition(conditions, lssv1alpha1.ConditionTypeInstallationReady, err, generation)
+		return err
 	}
 
+	readyConditionTypes := []string{
+		lssv1alpha1.ConditionTypeContextReady,
+		lssv1alpha1.ConditionTypeTargetReady,
+		lssv1alpha1.ConditionTypeInstallationReady,
+	}
+	if instance.IsInternalDataPlane() {
+		readyConditionTypes = append(readyConditionTypes, lssv1alpha1.ConditionTypeKubeconfigExported)
+	}
+	errors.SetReadyCondition(conditions, generation, readyConditionTypes...)
+
 	return nil
 }
 
+// setInstallationConditions sets the installation and kubeconfig export conditions of an instance.
+func setInstallationConditions(instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) {
+	conditions := &instance.Status.Conditions
+	generation := instance.GetGeneration()
+
+	switch installation.Status.InstallationPhase {
+	case lsv1alpha1.InstallationPhases.Succeeded:
+		errors.SetCondition(conditions, lssv1alpha1.ConditionTypeInstallationReady, metav1.ConditionTrue, "InstallationSucceeded", "", generation)
+	case lsv1alpha1.InstallationPhases.Failed, lsv1alpha1.InstallationPhases.DeleteFailed:
+		message := fmt.Sprintf("installation %s is in phase %s", client.ObjectKeyFromObject(installation).String(), installation.Status.InstallationPhase)
+		if installation.Status.LastError != nil {
+			message = fmt.Sprintf("%s: %s", message, installation.Status.LastError.Message)
+		}
+		errors.SetCondition(conditions, lssv1alpha1.ConditionTypeInstallationReady, metav1.ConditionFalse, "InstallationFailed", message, generation)
+	default:
+		message := fmt.Sprintf("installation %s is in phase %q", client.ObjectKeyFromObject(installation).String(), installation.Status.InstallationPhase)
+		errors.SetCondition(conditions, lssv1alpha1.ConditionTypeInstallationReady, metav1.ConditionFalse, "InstallationProgressing", message, generation)
+	}
+
+	if instance.IsInternalDataPlane() {
+		if instance.Status.UserKubeconfigSecretRef != nil && instance.Status.AdminKubeconfigSecretRef != nil {
+			errors.SetCondition(conditions, lssv1alpha1.ConditionTypeKubeconfigExported, metav1.ConditionTrue, "KubeconfigExported", "", generation)
+		} else {
+			errors.SetCondition(conditions, lssv1alpha1.ConditionTypeKubeconfigExported, metav1.ConditionFalse, "KubeconfigNotExported",
+				"the installation has not yet exported the user and admin kubeconfig", generation)
+		}
+	}
+}
+
 // reconcileContext reconciles the context for an instance.
 func (c *Controller) reconcileContext(ctx context.Context, instance *lssv1alpha1.Instance) error {
 	landscaperContext := &lsv1alpha1.Context{}
@@ -384,22 +460,11 @@ func (c *Controller) mutateExternalDataPlaneClusterTarget(ctx context.Context, t
 		return fmt.Errorf("unable to set controller reference for target: %w", err)
 	}
 
-	var kubeconfigStr string
-
-	if len(instance.Spec.DataPlane.Kubeconfig) > 0 {
-		kubeconfigStr = instance.Spec.DataPlane.Kubeconfig
-	} else {
-		secret := &corev1.Secret{}
-		if err := c.Client().Get(ctx, instance.Spec.DataPlane.SecretRef.NamespacedName(), secret); err != nil {
-			return fmt.Errorf("unable to get kubeconfig secret for gardener service account: %w", err)
-		}
-
-		val, ok := secret.Data[instance.Spec.DataPlane.SecretRef.Key]
-		if !ok {
-			return fmt.Errorf("unable to read kubeconfig from secret: missing key %q", instance.Spec.DataPlane.SecretRef.Key)
-		}
-		kubeconfigStr = string(val)
+	kubeconfig, err := utils.GetDataPlaneKubeconfig(ctx, c.Client(), instance.Spec.DataPlane)
+	if err != nil {
+		return err
 	}
+	kubeconfigStr := string(kubeconfig)
 
 	targetConfig := targettypes.KubernetesClusterTargetConfig{
 		Kubeconfig: targettypes.ValueRef{
@@ -450,31 +515,52 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 		}
 	}
 
-	_, err := kubernetes.CreateOrUpdate(ctx, c.Client(), installation, func() error {
+	component, err := c.getLandscaperServiceComponent(instance)
+	if err != nil {
+		return fmt.Errorf("unable to determine landscaper service component version: %w", err)
+	}
+
+	// the maintenance status is set while mutating the installation
+	old = instance.DeepCopy()
+	if instance.Status.Hibernation, err = computeHibernationStatus(instance, time.Now()); err != nil {
+		return fmt.Errorf("unable to determine hibernation status: %w", err)
+	}
+
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), installation, func() error {
 		if instance.IsInternalDataPlane() {
-			return c.mutateInstallation(ctx, installation, instance)
+			return c.mutateInstallation(ctx, installation, instance, component)
 		}
 
-		return c.mutateInstallationExternalDataPlane(ctx, installation, instance)
+		return c.mutateInstallationExternalDataPlane(ctx, installation, instance, component)
 	})
 
 	if err != nil {
 		return fmt.Errorf("unable to create/update installation: %w", err)
 	}
 
-	old = instance.DeepCopy()
+	if result == controllerutil.OperationResultCreated {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonInstallationCreated,
+			"Created installation %s", client.ObjectKeyFromObject(installation).String())
+	}
+
 	instance.Status.InstallationRef = &lssv1alpha1.ObjectReference{
 		Name:      installation.GetName(),
 		Namespace: installation.GetNamespace(),
 	}
 
-	instance.Status.LandscaperServiceComponent = &lssv1alpha1.LandscaperServiceComponent{
-		Name:    installation.Spec.ComponentDescriptor.Reference.ComponentName,
-		Version: installation.Spec.ComponentDescriptor.Reference.Version,
+	// the installed version differs from the resolved version while a version change is held
+	if installedVersion := installation.Spec.ComponentDescriptor.Reference.Version; installedVersion != component.Version {
+		if component, err = c.getLandscaperServiceComponentForVersion(installedVersion); err != nil {
+			return fmt.Errorf("unable to determine installed landscaper service component: %w", err)
+		}
 	}
+	instance.Status.LandscaperServiceComponent = component
 
 	if instance.IsInternalDataPlane() {
-		if err := c.handleExports(ctx, instance, installation); err != nil {
+		start := time.Now()
+		err := c.handleExports(ctx, instance, installation)
+		metrics.ObserveInstanceReconcileStep(metrics.InstanceStepExports, start, err)
+		if err != nil {
 			return err
 		}
 	}
@@ -484,6 +570,14 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 	}
 
 	instance.Status.Phase = string(installation.Status.InstallationPhase)
+	setInstallationConditions(instance, installation)
+
+	// the landscaper deployments have to be scaled up again when the hibernation has been removed
+	if instance.Status.Hibernation != nil || old.Status.Hibernation != nil {
+		if err := c.reconcileHibernation(ctx, instance, installation); err != nil {
+			return err
+		}
+	}
 
 	if !reflect.DeepEqual(old.Status, instance.Status) {
 		if err := c.Client().Status().Update(ctx, instance); err != nil {
@@ -495,7 +589,7 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 }
 
 // mutateInstallation creates or updates the installation for an instance.
-func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance) error {
+func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance, component *lssv1alpha1.LandscaperServiceComponent) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "mutateInstallation")
 
@@ -565,6 +659,21 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		shootConfig.Kubernetes.KubeAPIServer.OIDCConfig.GroupsClaim = instance.Spec.OIDCConfig.GroupsClaim
 	}
 
+	// the region of a shoot can't be changed, it is recorded when the shoot is created
+	if len(instance.Status.ShootRegion) == 0 {
+		recordShootRegion(instance, config, shootConfig, oldInstallationSpec, len(installation.Name) > 0)
+	}
+	if len(instance.Status.ShootRegion) > 0 {
+		shootConfig.Region = instance.Status.ShootRegion
+		shootConfig.Provider.Zone = instance.Status.ShootZone
+	}
+
+	if isHibernated(instance) {
+		shootConfig.Hibernation = &lssconfig.ShootHibernation{
+			Enabled: true,
+		}
+	}
+
 	if instance.Spec.HighAvailabilityConfig != nil {
 		shootConfig.ControlPlane = &lssconfig.ControlPlane{
 			HighAvailability: lssconfig.HighAvailability{
@@ -595,8 +704,8 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		Context: instance.Status.ContextRef.Name,
 		ComponentDescriptor: &lsv1alpha1.ComponentDescriptorDefinition{
 			Reference: &lsv1alpha1.ComponentDescriptorReference{
-				ComponentName: c.Config().LandscaperServiceComponent.Name,
-				Version:       c.Config().LandscaperServiceComponent.Version,
+				ComponentName: component.Name,
+				Version:       component.Version,
 			},
 		},
 		Blueprint: lsv1alpha1.BlueprintDefinition{
@@ -690,6 +799,15 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		installation.Spec.ImportDataMappings[lsinstallation.AuditLogServiceImportName] = lsv1alpha1.NewAnyJSON(auditLogServiceRaw)
 	}
 
+	if isHibernated(instance) {
+		// an automatic reconcile of the installation would scale up the landscaper deployments of a hibernated instance
+		installation.Spec.AutomaticReconcile = nil
+	}
+
+	if err := c.holdInstallationChanges(instance, installation, oldInstallationSpec); err != nil {
+		return err
+	}
+
 	if !InstallationSpecDeepEquals(oldInstallationSpec, installation.Spec.DeepCopy()) {
 		// set reconcile annotation to start/update the installation
 		logger.Info("Setting reconcile operation annotation")
@@ -702,8 +820,37 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 	return nil
 }
 
+// recordShootRegion records the region and zone of the resource shoot cluster of an instance.
+// For an existing installation these are taken from its shoot configuration, so that an existing shoot is never moved.
+// A new shoot cluster is created in the region of the target cluster, if the topology of the service target config
+// defines one for the configured provider, otherwise in the configured region.
+func recordShootRegion(instance *lssv1alpha1.Instance, config *lssv1alpha1.ServiceTargetConfig, shootConfig *lssconfig.ShootConfiguration,
+	installationSpec *lsv1alpha1.InstallationSpec, installationExists bool) {
+
+	region, zone := shootConfig.Region, shootConfig.Provider.Zone
+
+	if installationExists {
+		if raw, ok := installationSpec.ImportDataMappings[lsinstallation.ShootConfigImportName]; ok {
+			installedShootConfig := &lssconfig.ShootConfiguration{}
+			if err := json.Unmarshal(raw.RawMessage, installedShootConfig); err == nil && len(installedShootConfig.Region) > 0 {
+				region, zone = installedShootConfig.Region, installedShootConfig.Provider.Zone
+			}
+		}
+	} else if topology := config.Spec.Topology; topology != nil && len(topology.Region) > 0 &&
+		(len(topology.Provider) == 0 || topology.Provider == shootConfig.Provider.Type) {
+		// Create the resource shoot cluster in the region of the target cluster
+		region = topology.Region
+		if len(topology.Zone) > 0 {
+			zone = topology.Zone
+		}
+	}
+
+	instance.Status.ShootRegion = region
+	instance.Status.ShootZone = zone
+}
+
 // mutateInstallation creates or updates the installation for an instance.
-func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance) error {
+func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance, component *lssv1alpha1.LandscaperServiceComponent) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "mutateInstallationExternalDataPlane")
 
@@ -766,8 +913,8 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 		Context: instance.Status.ContextRef.Name,
 		ComponentDescriptor: &lsv1alpha1.ComponentDescriptorDefinition{
 			Reference: &lsv1alpha1.ComponentDescriptorReference{
-				ComponentName: c.Config().LandscaperServiceComponent.Name,
-				Version:       c.Config().LandscaperServiceComponent.Version,
+				ComponentName: component.Name,
+				Version:       component.Version,
 			},
 		},
 		Blueprint: lsv1alpha1.BlueprintDefinition{
@@ -806,6 +953,15 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 		},
 	}
 
+	if isHibernated(instance) {
+		// an automatic reconcile of the installation would scale up the landscaper deployments of a hibernated instance
+		installation.Spec.AutomaticReconcile = nil
+	}
+
+	if err := c.holdInstallationChanges(instance, installation, oldInstallationSpec); err != nil {
+		return err
+	}
+
 	if !InstallationSpecDeepEquals(oldInstallationSpec, installation.Spec.DeepCopy()) {
 		// set reconcile annotation to start/update the installation
 		logger.Info("Setting reconcile operation annotation")
@@ -818,7 +974,8 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 	return nil
 }
 
-// handleExports tries to find the exports of the installation and update the instance status accordingly.
+// handleExports reads the exports of the installation of an instance.
+// The kubeconfigs are written into secrets, which are referenced in the instance status.
 func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "handleExports")
@@ -839,6 +996,8 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 		return fmt.Errorf("unable to list data objects for ClusterKubeconfig: %w", err)
 	}
 
+	var userKubeconfig, adminKubeconfig string
+
 	if len(dataObjects.Items) > 0 {
 		userKubeconfigExportName := lsinstallation.GetInstallationExportDataRef(instance, lsinstallation.UserKubeconfigExportName)
 		adminKubeconfigExportName := lsinstallation.GetInstallationExportDataRef(instance, lsinstallation.AdminKubeconfigExportName)
@@ -854,13 +1013,13 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 			case userKubeconfigExportName:
 				logger.Info("found export data object for user kubeconfig",
 					lc.KeyResource, types.NamespacedName{Name: do.Name, Namespace: do.Namespace}.String())
-				if err := json.Unmarshal(do.Data.RawMessage, &instance.Status.UserKubeconfig); err != nil {
+				if err := json.Unmarshal(do.Data.RawMessage, &userKubeconfig); err != nil {
 					return fmt.Errorf("unable to unmarshal user kubeconfig: %w", err)
 				}
 			case adminKubeconfigExportName:
-				logger.Info("found export data object for user kubeconfig",
+				logger.Info("found export data object for admin kubeconfig",
 					lc.KeyResource, types.NamespacedName{Name: do.Name, Namespace: do.Namespace}.String())
-				if err := json.Unmarshal(do.Data.RawMessage, &instance.Status.AdminKubeconfig); err != nil {
+				if err := json.Unmarshal(do.Data.RawMessage, &adminKubeconfig); err != nil {
 					return fmt.Errorf("unable to unmarshal admin kubeconfig: %w", err)
 				}
 			case clusterEndpointExportName:
@@ -875,9 +1034,104 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 		}
 	}
 
+	kubeconfigsConfig := &c.Config().InstanceKubeconfigs
+	rotationConfig := lsinstallation.NewRotationConfig(tokenExpirationSeconds, adminKubeconfigExpirationSeconds)
+
+	if len(userKubeconfig) > 0 {
+		secretRef, expirationTime, err := c.reconcileKubeconfigSecret(ctx, instance, kubeconfigTypeUser, kubeconfigsConfig.UserKubeconfigSecretNameSuffix,
+			userKubeconfig, rotationConfig.TokenExpirationSeconds, instance.Status.UserKubeconfigExpirationTime)
+		if err != nil {
+			return err
+		}
+		instance.Status.UserKubeconfigSecretRef = secretRef
+		instance.Status.UserKubeconfigExpirationTime = expirationTime
+	}
+
+	if len(adminKubeconfig) > 0 {
+		secretRef, expirationTime, err := c.reconcileKubeconfigSecret(ctx, instance, kubeconfigTypeAdmin, kubeconfigsConfig.AdminKubeconfigSecretNameSuffix,
+			adminKubeconfig, rotationConfig.AdminKubeconfigExpirationSeconds, instance.Status.AdminKubeconfigExpirationTime)
+		if err != nil {
+			return err
+		}
+		instance.Status.AdminKubeconfigSecretRef = secretRef
+		instance.Status.AdminKubeconfigExpirationTime = expirationTime
+	}
+
+	if kubeconfigsConfig.StoreInStatus {
+		if len(userKubeconfig) > 0 {
+			instance.Status.UserKubeconfig = userKubeconfig
+		}
+		if len(adminKubeconfig) > 0 {
+			instance.Status.AdminKubeconfig = adminKubeconfig
+		}
+	} else {
+		instance.Status.UserKubeconfig = ""
+		instance.Status.AdminKubeconfig = ""
+	}
+
 	return nil
 }
 
+// reconcileKubeconfigSecret creates or updates the secret containing an exported kubeconfig of an instance.
+// The exported kubeconfig is base64 encoded and is stored decoded in the secret.
+// The expiration time is renewed whenever the kubeconfig has changed.
+func (c *Controller) reconcileKubeconfigSecret(ctx context.Context, instance *lssv1alpha1.Instance, kubeconfigType, nameSuffix, encodedKubeconfig string,
+	expirationSeconds int64, expirationTime *metav1.Time) (*lssv1alpha1.SecretReference, *metav1.Time, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "reconcileKubeconfigSecret")
+
+	kubeconfig, err := base64.StdEncoding.DecodeString(encodedKubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode %s kubeconfig: %w", kubeconfigType, err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = fmt.Sprintf("%s-%s", instance.GetName(), nameSuffix)
+	secret.Namespace = instance.GetNamespace()
+
+	changed := false
+	_, err = kubernetes.CreateOrUpdate(ctx, c.Client(), secret, func() error {
+		if err := controllerutil.SetControllerReference(instance, secret, c.Scheme()); err != nil {
+			return fmt.Errorf("unable to set controller reference for kubeconfig secret: %w", err)
+		}
+
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		for k, v := range c.Config().InstanceKubeconfigs.SecretLabels {
+			secret.Labels[k] = v
+		}
+		secret.Labels[lssv1alpha1.InstanceKubeconfigTypeLabel] = kubeconfigType
+
+		if !bytes.Equal(secret.Data[kubeconfigSecretKey], kubeconfig) {
+			changed = true
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			kubeconfigSecretKey: kubeconfig,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create/update %s kubeconfig secret: %w", kubeconfigType, err)
+	}
+
+	if changed || expirationTime == nil {
+		logger.Info("Kubeconfig secret updated", lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+		expirationTime = &metav1.Time{Time: time.Now().Add(time.Duration(expirationSeconds) * time.Second)}
+	}
+
+	secretRef := &lssv1alpha1.SecretReference{
+		ObjectReference: lssv1alpha1.ObjectReference{
+			Name:      secret.GetName(),
+			Namespace: secret.GetNamespace(),
+		},
+		Key: kubeconfigSecretKey,
+	}
+	return secretRef, expirationTime, nil
+}
+
 // handleShootName tries to generate a shoot name if it not already exists.
 func (c *Controller) handleShootName(ctx context.Context, instance *lssv1alpha1.Instance) error {
 	shootNamespace := fmt.Sprintf("garden-%s", c.Config().GardenerConfiguration.ProjectName)
@@ -902,6 +1156,8 @@ func (c *Controller) handleShootName(ctx context.Context, instance *lssv1alpha1.
 	}
 
 	instance.Status.ShootName = shootName
+	c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonShootNameAllocated,
+		"Allocated shoot name %s in namespace %s", shootName, shootNamespace)
 
 	return nil
 }
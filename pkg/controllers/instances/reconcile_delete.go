@@ -26,6 +26,7 @@ import (
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
@@ -156,6 +157,8 @@ func (c *Controller) ensureDeleteInstallationForInstance(ctx context.Context, in
 		if err := c.Client().Delete(ctx, installation); err != nil {
 			return false, fmt.Errorf("unable to delete installation for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting installation %s", instance.Status.InstallationRef.NamespacedName().String())
 	}
 
 	return false, nil
@@ -185,6 +188,8 @@ func (c *Controller) ensureDeleteTargetForInstance(ctx context.Context, instance
 		if err := c.Client().Delete(ctx, target); err != nil {
 			return false, fmt.Errorf("unable to delete target for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting target %s", instance.Status.TargetRef.NamespacedName().String())
 	}
 
 	return false, nil
@@ -214,6 +219,8 @@ func (c *Controller) ensureDeleteGardenerServiceAccountTargetForInstance(ctx con
 		if err := c.Client().Delete(ctx, target); err != nil {
 			return false, fmt.Errorf("unable to delete gardener service account target for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting gardener service account target %s", instance.Status.GardenerServiceAccountRef.NamespacedName().String())
 	}
 
 	return false, nil
@@ -243,6 +250,8 @@ func (c *Controller) ensureDeleteDataPlaneClusterTargetForInstance(ctx context.C
 		if err := c.Client().Delete(ctx, target); err != nil {
 			return false, fmt.Errorf("unable to delete external data plane cluster target for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting external data plane cluster target %s", instance.Status.ExternalDataPlaneClusterRef.NamespacedName().String())
 	}
 
 	return false, nil
@@ -276,6 +285,8 @@ func (c *Controller) ensureDeleteContextForInstance(ctx context.Context, instanc
 		if err := c.Client().Delete(ctx, landscaperContext); err != nil {
 			return false, fmt.Errorf("unable to delete context for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting context %s", instance.Status.ContextRef.NamespacedName().String())
 	}
 
 	return false, nil
@@ -345,6 +356,8 @@ func (c *Controller) ensureDeleteTargetClusterNamespace(ctx context.Context, ins
 		if err = targetClusterClient.Delete(ctx, namespace); err != nil {
 			return false, fmt.Errorf("failed to delete target cluster namespace %q: %w", targetClusterNamespace, err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting target cluster namespace %s", targetClusterNamespace)
 		return false, nil
 	}
 
@@ -14,6 +14,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -102,6 +103,35 @@ var _ = Describe("Delete", func() {
 		Expect(config.Status.InstanceRefs).To(HaveLen(1))
 	})
 
+	It("should record events for the deletion of the associated resources", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/delete/test3")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(20)
+		ctrl = instancescontroller.NewTestActuator(*op.WithEventRecorder(recorder), logging.Discard())
+
+		instance := state.GetInstance("test")
+		target := state.GetTarget("test")
+		gardenerSa := state.GetTarget("test-gardener-sa")
+		installation := state.GetInstallation("test")
+		context := state.GetContext("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Delete(ctx, instance)).To(Succeed())
+		for i := 0; i < 5; i++ {
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		}
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, instance, 5*time.Second)).To(Succeed())
+
+		Expect(receivedEvents(recorder)).To(ContainElements(
+			fmt.Sprintf("Normal %s Deleting installation %s", operation.EventReasonDeleting, kutil.ObjectKeyFromObject(installation).String()),
+			fmt.Sprintf("Normal %s Deleting target %s", operation.EventReasonDeleting, kutil.ObjectKeyFromObject(target).String()),
+			fmt.Sprintf("Normal %s Deleting gardener service account target %s", operation.EventReasonDeleting, kutil.ObjectKeyFromObject(gardenerSa).String()),
+			fmt.Sprintf("Normal %s Deleting context %s", operation.EventReasonDeleting, kutil.ObjectKeyFromObject(context).String()),
+		))
+	})
+
 	It("should remove the associated context, target and installation  (internal data plane)", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/delete/test3")
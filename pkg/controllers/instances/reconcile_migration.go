@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// migrationRetryDuration is the duration after which a migration step that waits for a deletion is retried.
+	migrationRetryDuration = time.Second * 10
+)
+
+// isMigrationPending returns true if the instance has a migration annotation or a migration is in progress.
+func isMigrationPending(instance *lssv1alpha1.Instance) bool {
+	if _, ok := instance.GetAnnotations()[lssv1alpha1.InstanceMigrationAnnotation]; ok {
+		return true
+	}
+	migration := instance.Status.Migration
+	return migration != nil && migration.Phase != lssv1alpha1.InstanceMigrationPhaseSucceeded
+}
+
+// handleMigration migrates an instance to the ServiceTargetConfig specified by the migration annotation.
+// The installation is deleted without uninstalling the landscaper, so that the shoot cluster or data plane is retained.
+// After the landscaper has been removed from the source target cluster, the target and context are recreated
+// and the installation is created again for the new target cluster.
+func (c *Controller) handleMigration(ctx context.Context, instance *lssv1alpha1.Instance) (reconcile.Result, error) {
+	curOp := "Migrate"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "handleMigration")
+
+	if instance.Status.Migration == nil || !instance.Status.Migration.IsStarted() {
+		started, err := c.startMigration(ctx, instance)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !started {
+			return reconcile.Result{Requeue: true}, nil
+		}
+	}
+
+	for {
+		// the migration status is replaced by every status update, hence it is read again in every iteration
+		migration := instance.Status.Migration
+		logger.Info("Migrating instance", "phase", migration.Phase,
+			"source", migration.SourceServiceTargetConfigRef.NamespacedName().String(),
+			"target", migration.TargetServiceTargetConfigRef.NamespacedName().String())
+
+		switch migration.Phase {
+		case lssv1alpha1.InstanceMigrationPhaseDeletingInstallation:
+			deleted, err := c.ensureDeleteInstallationWithoutUninstall(ctx, instance)
+			if err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "MigrationDeleteInstallation", err.Error())
+			}
+			if !deleted {
+				return reconcile.Result{Requeue: true, RequeueAfter: migrationRetryDuration}, nil
+			}
+			if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseCleaningUpSourceCluster, ""); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+			}
+
+		case lssv1alpha1.InstanceMigrationPhaseCleaningUpSourceCluster:
+			deleted, err := c.ensureDeleteTargetClusterNamespace(ctx, instance)
+			if err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "MigrationCleanupSourceCluster", err.Error())
+			}
+			if !deleted {
+				return reconcile.Result{Requeue: true, RequeueAfter: migrationRetryDuration}, nil
+			}
+			if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseDeletingTarget, ""); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+			}
+
+		case lssv1alpha1.InstanceMigrationPhaseDeletingTarget:
+			deleted, err := c.ensureDeleteTargetAndContext(ctx, instance)
+			if err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "MigrationDeleteTarget", err.Error())
+			}
+			if !deleted {
+				return reconcile.Result{Requeue: true, RequeueAfter: migrationRetryDuration}, nil
+			}
+			if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseSwitchingServiceTargetConfig, ""); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+			}
+
+		case lssv1alpha1.InstanceMigrationPhaseSwitchingServiceTargetConfig:
+			if err := c.switchServiceTargetConfig(ctx, instance); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "MigrationSwitchServiceTargetConfig", err.Error())
+			}
+			instance.Status.Phase = ""
+			if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseInstalling, ""); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+			}
+
+		case lssv1alpha1.InstanceMigrationPhaseInstalling:
+			if err := c.ReconcileFunc(ctx, instance); err != nil {
+				return reconcile.Result{}, err
+			}
+			if instance.Status.Phase != string(lsv1alpha1.InstallationPhases.Succeeded) {
+				return reconcile.Result{Requeue: true, RequeueAfter: migrationRetryDuration}, nil
+			}
+			if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseSucceeded, ""); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+			}
+			if err := c.removeMigrationAnnotation(ctx, instance); err != nil {
+				return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "RemoveMigrationAnnotation", err.Error())
+			}
+			logger.Info("Migration succeeded")
+			return computeAutomaticReconcile(instance, nil)
+
+		default:
+			err := fmt.Errorf("unknown migration phase %q", migration.Phase)
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "UnknownMigrationPhase", err.Error())
+		}
+	}
+}
+
+// startMigration validates the migration annotation and starts the migration if all preconditions are fulfilled.
+// Returns false if the migration has not been started.
+func (c *Controller) startMigration(ctx context.Context, instance *lssv1alpha1.Instance) (bool, error) {
+	curOp := "Migrate"
+
+	targetRef, err := instance.GetMigrationTarget()
+	if err != nil {
+		return false, lsserrors.NewWrappedError(err, curOp, "InvalidMigrationAnnotation", err.Error())
+	}
+
+	// the annotation has been removed before the migration has been started
+	if targetRef == nil {
+		instance.Status.Migration = nil
+		if err := c.Client().Status().Update(ctx, instance); err != nil {
+			return false, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+		}
+		return false, nil
+	}
+
+	// the instance is already using the requested service target config
+	if targetRef.Equals(&instance.Spec.ServiceTargetConfigRef) {
+		if err := c.removeMigrationAnnotation(ctx, instance); err != nil {
+			return false, lsserrors.NewWrappedError(err, curOp, "RemoveMigrationAnnotation", err.Error())
+		}
+		return false, nil
+	}
+
+	now := metav1.Now()
+	if instance.Status.Migration == nil ||
+		instance.Status.Migration.Phase != lssv1alpha1.InstanceMigrationPhasePending ||
+		!instance.Status.Migration.TargetServiceTargetConfigRef.Equals(targetRef) {
+		instance.Status.Migration = &lssv1alpha1.InstanceMigrationStatus{
+			Phase:                        lssv1alpha1.InstanceMigrationPhasePending,
+			SourceServiceTargetConfigRef: instance.Spec.ServiceTargetConfigRef,
+			TargetServiceTargetConfigRef: *targetRef,
+			StartTime:                    now,
+			LastTransitionTime:           now,
+		}
+	}
+
+	serviceTargetConfig := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, targetRef.NamespacedName(), serviceTargetConfig); err != nil {
+		instance.Status.Migration.Message = fmt.Sprintf("unable to get service target config %s: %s", targetRef.NamespacedName().String(), err.Error())
+		if err2 := c.Client().Status().Update(ctx, instance); err2 != nil {
+			return false, lsserrors.NewWrappedError(err2, curOp, "UpdateMigrationStatus", err2.Error())
+		}
+		return false, lsserrors.NewWrappedError(err, curOp, "GetMigrationServiceTargetConfig", err.Error())
+	}
+
+	if serviceTargetConfig.IsCordoned() {
+		err := fmt.Errorf("service target config %s is cordoned: %s", targetRef.NamespacedName().String(), serviceTargetConfig.Spec.Cordon.Reason)
+		instance.Status.Migration.Message = err.Error()
+		if err2 := c.Client().Status().Update(ctx, instance); err2 != nil {
+			return false, lsserrors.NewWrappedError(err2, curOp, "UpdateMigrationStatus", err2.Error())
+		}
+		return false, lsserrors.NewWrappedError(err, curOp, "MigrationServiceTargetConfigCordoned", err.Error())
+	}
+
+	if serviceTargetConfig.IsNotReady() {
+		err := fmt.Errorf("service target config %s is not ready", targetRef.NamespacedName().String())
+		instance.Status.Migration.Message = err.Error()
+		if err2 := c.Client().Status().Update(ctx, instance); err2 != nil {
+			return false, lsserrors.NewWrappedError(err2, curOp, "UpdateMigrationStatus", err2.Error())
+		}
+		return false, lsserrors.NewWrappedError(err, curOp, "MigrationServiceTargetConfigNotReady", err.Error())
+	}
+
+	if err := c.setMigrationPhase(ctx, instance, lssv1alpha1.InstanceMigrationPhaseDeletingInstallation, ""); err != nil {
+		return false, lsserrors.NewWrappedError(err, curOp, "UpdateMigrationStatus", err.Error())
+	}
+	return true, nil
+}
+
+// setMigrationPhase sets the migration phase and updates the instance status.
+func (c *Controller) setMigrationPhase(ctx context.Context, instance *lssv1alpha1.Instance, phase lssv1alpha1.InstanceMigrationPhase, message string) error {
+	instance.Status.Migration.Phase = phase
+	instance.Status.Migration.Message = message
+	instance.Status.Migration.LastTransitionTime = metav1.Now()
+	if err := c.Client().Status().Update(ctx, instance); err != nil {
+		return fmt.Errorf("unable to update migration status: %w", err)
+	}
+
+	migration := instance.Status.Migration
+	if phase == lssv1alpha1.InstanceMigrationPhaseSucceeded {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonMigrated,
+			"Migrated from service target config %s to %s", migration.SourceServiceTargetConfigRef.NamespacedName().String(),
+			migration.TargetServiceTargetConfigRef.NamespacedName().String())
+	} else {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, operation.EventReasonMigrating,
+			"Migrating to service target config %s: %s", migration.TargetServiceTargetConfigRef.NamespacedName().String(), phase)
+	}
+	return nil
+}
+
+// removeMigrationAnnotation removes the migration annotation from the instance.
+func (c *Controller) removeMigrationAnnotation(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	if _, ok := instance.GetAnnotations()[lssv1alpha1.InstanceMigrationAnnotation]; !ok {
+		return nil
+	}
+	delete(instance.Annotations, lssv1alpha1.InstanceMigrationAnnotation)
+	if err := c.Client().Update(ctx, instance); err != nil {
+		return fmt.Errorf("unable to remove migration annotation: %w", err)
+	}
+	return nil
+}
+
+// ensureDeleteInstallationWithoutUninstall ensures that the installation for an instance is deleted,
+// without uninstalling the landscaper and the shoot cluster.
+func (c *Controller) ensureDeleteInstallationWithoutUninstall(ctx context.Context, instance *lssv1alpha1.Instance) (bool, error) {
+	if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.IsEmpty() {
+		return true, nil
+	}
+
+	installation := &lsv1alpha1.Installation{}
+	if err := c.Client().Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("unable to get installation for instance: %w", err)
+		}
+	} else if installation.GetAnnotations()[lsv1alpha1.DeleteWithoutUninstallAnnotation] != "true" {
+		if installation.Annotations == nil {
+			installation.Annotations = map[string]string{}
+		}
+		installation.Annotations[lsv1alpha1.DeleteWithoutUninstallAnnotation] = "true"
+		if err := c.Client().Update(ctx, installation); err != nil {
+			return false, fmt.Errorf("unable to set delete without uninstall annotation: %w", err)
+		}
+	}
+
+	return c.ensureDeleteInstallationForInstance(ctx, instance)
+}
+
+// ensureDeleteTargetAndContext ensures that the target and the context for an instance are deleted.
+func (c *Controller) ensureDeleteTargetAndContext(ctx context.Context, instance *lssv1alpha1.Instance) (bool, error) {
+	if instance.Status.TargetRef != nil && !instance.Status.TargetRef.IsEmpty() {
+		if deleted, err := c.ensureDeleteTargetForInstance(ctx, instance); err != nil || !deleted {
+			return false, err
+		}
+	}
+
+	if instance.Status.ContextRef != nil && !instance.Status.ContextRef.IsEmpty() {
+		if deleted, err := c.ensureDeleteContextForInstance(ctx, instance); err != nil || !deleted {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// switchServiceTargetConfig moves the instance reference from the source to the target service target config
+// and updates the service target config reference of the instance.
+func (c *Controller) switchServiceTargetConfig(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	migration := instance.Status.Migration
+	instanceRef := &lssv1alpha1.ObjectReference{
+		Name:      instance.GetName(),
+		Namespace: instance.GetNamespace(),
+	}
+
+	source := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, migration.SourceServiceTargetConfigRef.NamespacedName(), source); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get source service target config: %w", err)
+		}
+	} else if utils.ContainsReference(source.Status.InstanceRefs, instanceRef) {
+		source.Status.InstanceRefs = utils.RemoveReference(source.Status.InstanceRefs, instanceRef)
+		if err := c.Client().Status().Update(ctx, source); err != nil {
+			return fmt.Errorf("unable to remove instance reference from source service target config: %w", err)
+		}
+	}
+
+	target := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, migration.TargetServiceTargetConfigRef.NamespacedName(), target); err != nil {
+		return fmt.Errorf("unable to get target service target config: %w", err)
+	}
+	if !utils.ContainsReference(target.Status.InstanceRefs, instanceRef) {
+		target.Status.InstanceRefs = append(target.Status.InstanceRefs, *instanceRef)
+		if err := c.Client().Status().Update(ctx, target); err != nil {
+			return fmt.Errorf("unable to add instance reference to target service target config: %w", err)
+		}
+	}
+
+	if !instance.Spec.ServiceTargetConfigRef.Equals(&migration.TargetServiceTargetConfigRef) {
+		instance.Spec.ServiceTargetConfigRef = migration.TargetServiceTargetConfigRef
+		if err := c.Client().Update(ctx, instance); err != nil {
+			return fmt.Errorf("unable to update service target config reference: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	instancescontroller "github.com/gardener/landscaper-service/pkg/controllers/instances"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Migration", func() {
+	const (
+		uniqueId = "a1b2c3d4e6"
+	)
+
+	var (
+		op    *operation.Operation
+		ctrl  *instancescontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		Expect(testutils.CreateServiceAccountSecret(ctx, op.Client(), op.Config())).To(Succeed())
+		ctrl = instancescontroller.NewTestActuator(*op, logging.Discard())
+		ctrl.ListShootsFunc = func(ctx context.Context, instance *lssv1alpha1.Instance) (*unstructured.UnstructuredList, error) {
+			return &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{},
+			}, nil
+		}
+		ctrl.UniqueIDFunc = func() string {
+			return uniqueId
+		}
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should migrate an instance to a different service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/migration/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+		source := state.GetConfig("default")
+		target := state.GetConfig("other")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.InstallationRef).ToNot(BeNil())
+		Expect(instance.Status.TargetRef).ToNot(BeNil())
+
+		oldInstallationRef := *instance.Status.InstallationRef
+		oldTargetRef := *instance.Status.TargetRef
+		shootName := instance.Status.ShootName
+		instanceRef := lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		source.Status.InstanceRefs = append(source.Status.InstanceRefs, instanceRef)
+		Expect(testenv.Client.Status().Update(ctx, source)).To(Succeed())
+
+		annotations := instance.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[lssv1alpha1.InstanceMigrationAnnotation] = fmt.Sprintf("%s/%s", target.Namespace, target.Name)
+		instance.SetAnnotations(annotations)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		for i := 0; i < 10; i++ {
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+			if instance.Status.Migration.Phase == lssv1alpha1.InstanceMigrationPhaseInstalling {
+				break
+			}
+		}
+		Expect(instance.Status.Migration).ToNot(BeNil())
+		Expect(instance.Status.Migration.Phase).To(Equal(lssv1alpha1.InstanceMigrationPhaseInstalling))
+		Expect(instance.Status.Migration.SourceServiceTargetConfigRef.Name).To(Equal(source.Name))
+		Expect(instance.Status.Migration.TargetServiceTargetConfigRef.Name).To(Equal(target.Name))
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal(target.Name))
+		Expect(instance.Status.ShootName).To(Equal(shootName))
+
+		// the old installation must have been deleted without uninstalling the shoot cluster
+		oldInstallation := &lsv1alpha1.Installation{}
+		err = testenv.Client.Get(ctx, oldInstallationRef.NamespacedName(), oldInstallation)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		oldTarget := &lsv1alpha1.Target{}
+		err = testenv.Client.Get(ctx, oldTargetRef.NamespacedName(), oldTarget)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(utils.ContainsReference(source.Status.InstanceRefs, &instanceRef)).To(BeFalse())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(target), target)).To(Succeed())
+		Expect(utils.ContainsReference(target.Status.InstanceRefs, &instanceRef)).To(BeTrue())
+
+		Expect(instance.Status.InstallationRef).ToNot(BeNil())
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Migration.Phase).To(Equal(lssv1alpha1.InstanceMigrationPhaseSucceeded))
+		Expect(instance.GetAnnotations()).ToNot(HaveKey(lssv1alpha1.InstanceMigrationAnnotation))
+		Expect(instance.Status.TargetRef).ToNot(BeNil())
+		Expect(instance.Status.TargetRef.Name).ToNot(Equal(oldTargetRef.Name))
+	})
+
+	It("should keep a migration pending while the target service target config does not exist", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/migration/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		instance.SetAnnotations(map[string]string{
+			lssv1alpha1.InstanceMigrationAnnotation: fmt.Sprintf("%s/%s", instance.Namespace, "missing"),
+		})
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Migration).ToNot(BeNil())
+		Expect(instance.Status.Migration.Phase).To(Equal(lssv1alpha1.InstanceMigrationPhasePending))
+		Expect(instance.Status.LastError).ToNot(BeNil())
+		Expect(instance.Status.LastError.Reason).To(Equal("GetMigrationServiceTargetConfig"))
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("default"))
+
+		// removing the annotation cancels the pending migration
+		instance.SetAnnotations(nil)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Migration).To(BeNil())
+	})
+})
@@ -6,6 +6,7 @@ package instances_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,10 +18,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -37,6 +42,15 @@ import (
 	"github.com/gardener/landscaper-service/test/utils/envtest"
 )
 
+// receivedEvents returns the events which have been recorded so far.
+func receivedEvents(recorder *record.FakeRecorder) []string {
+	events := []string{}
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	return events
+}
+
 var _ = Describe("Reconcile", func() {
 	const (
 		uniqueId = "a1b2c3d4e6"
@@ -101,6 +115,18 @@ var _ = Describe("Reconcile", func() {
 		Expect(instance.Status.TargetRef).ToNot(BeNil())
 		Expect(instance.Status.InstallationRef).ToNot(BeNil())
 
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeContextReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeTargetReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(instance.Status.Conditions, lssv1alpha1.ConditionTypeKubeconfigExported)).To(BeTrue())
+		installationReady := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.ConditionTypeInstallationReady)
+		Expect(installationReady).ToNot(BeNil())
+		Expect(installationReady.Status).To(Equal(metav1.ConditionFalse))
+		Expect(installationReady.Reason).To(Equal("InstallationProgressing"))
+		ready := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.ConditionTypeReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("InstallationProgressing"))
+
 		context := &lsv1alpha1.Context{}
 		Expect(testenv.Client.Get(ctx, types.NamespacedName{Name: instance.Status.ContextRef.Name, Namespace: instance.Status.ContextRef.Namespace}, context)).To(Succeed())
 		Expect(context.RepositoryContext).ToNot(BeNil())
@@ -165,7 +191,7 @@ var _ = Describe("Reconcile", func() {
 		}
 		Expect(testenv.Client.Create(ctx, endpointExport)).To(Succeed())
 
-		userKubeConfig := "userkubeconfigdata"
+		userKubeConfig := base64.StdEncoding.EncodeToString([]byte("userkubeconfigdata"))
 		userKubeconfigExport := &lsv1alpha1.DataObject{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "userkubeconfigexport",
@@ -180,7 +206,7 @@ var _ = Describe("Reconcile", func() {
 		}
 		Expect(testenv.Client.Create(ctx, userKubeconfigExport)).To(Succeed())
 
-		adminKubeConfig := "adminkubeconfigdata"
+		adminKubeConfig := base64.StdEncoding.EncodeToString([]byte("adminkubeconfigdata"))
 		adminKubeconfigExport := &lsv1alpha1.DataObject{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "adminkubeconfigexport",
@@ -199,10 +225,76 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
 
 		Expect(instance.Status.ClusterEndpoint).To(Equal(clusterEndpoint))
+		Expect(instance.Status.UserKubeconfig).To(BeEmpty())
+		Expect(instance.Status.AdminKubeconfig).To(BeEmpty())
+		Expect(instance.Status.UserKubeconfigExpirationTime).ToNot(BeNil())
+		Expect(instance.Status.AdminKubeconfigExpirationTime).ToNot(BeNil())
+		Expect(instance.Status.AdminKubeconfigExpirationTime.Time).To(BeTemporally("<", instance.Status.UserKubeconfigExpirationTime.Time))
+
+		Expect(instance.Status.UserKubeconfigSecretRef).ToNot(BeNil())
+		userKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.UserKubeconfigSecretRef.NamespacedName(), userKubeconfigSecret)).To(Succeed())
+		Expect(userKubeconfigSecret.Name).To(Equal("test-user-kubeconfig"))
+		Expect(userKubeconfigSecret.Labels).To(HaveKeyWithValue(lssv1alpha1.InstanceKubeconfigTypeLabel, "user"))
+		Expect(userKubeconfigSecret.Data[instance.Status.UserKubeconfigSecretRef.Key]).To(Equal([]byte("userkubeconfigdata")))
+		Expect(userKubeconfigSecret.OwnerReferences).To(HaveLen(1))
+		Expect(userKubeconfigSecret.OwnerReferences[0].UID).To(Equal(instance.UID))
+
+		Expect(instance.Status.AdminKubeconfigSecretRef).ToNot(BeNil())
+		adminKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.AdminKubeconfigSecretRef.NamespacedName(), adminKubeconfigSecret)).To(Succeed())
+		Expect(adminKubeconfigSecret.Name).To(Equal("test-admin-kubeconfig"))
+		Expect(adminKubeconfigSecret.Labels).To(HaveKeyWithValue(lssv1alpha1.InstanceKubeconfigTypeLabel, "admin"))
+		Expect(adminKubeconfigSecret.Data[instance.Status.AdminKubeconfigSecretRef.Key]).To(Equal([]byte("adminkubeconfigdata")))
+
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeKubeconfigExported)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeReady)).To(BeFalse())
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(installation), installation)).To(Succeed())
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeInstallationReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.ConditionTypeReady)).To(BeTrue())
+
+		// the deprecated behaviour additionally writes the kubeconfigs into the status
+		op.Config().InstanceKubeconfigs.StoreInStatus = true
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
 		Expect(instance.Status.UserKubeconfig).To(Equal(userKubeConfig))
 		Expect(instance.Status.AdminKubeconfig).To(Equal(adminKubeConfig))
 	})
 
+	It("should record events for the allocation of the shoot name and the creation of the installation", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(20)
+		ctrl.Operation = *op.WithEventRecorder(recorder)
+
+		instance := state.GetInstance("test")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.InstallationRef).ToNot(BeNil())
+
+		events := receivedEvents(recorder)
+		Expect(events).To(ContainElements(
+			fmt.Sprintf("Normal %s Allocated shoot name %s in namespace garden-test", operation.EventReasonShootNameAllocated, instance.Status.ShootName),
+			fmt.Sprintf("Normal %s Created installation %s", operation.EventReasonInstallationCreated, instance.Status.InstallationRef.NamespacedName().String()),
+		))
+
+		// the milestones are only recorded once
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(receivedEvents(recorder)).ToNot(ContainElement(Or(
+			ContainSubstring(operation.EventReasonShootNameAllocated),
+			ContainSubstring(operation.EventReasonInstallationCreated),
+		)))
+	})
+
 	It("should create a context, target and an installation (external data plane)", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test7")
@@ -424,6 +516,12 @@ var _ = Describe("Reconcile", func() {
 		Expect(instance.Status.LastError.Message).To(Equal(message))
 		Expect(instance.Status.LastError.LastUpdateTime.Time).Should(BeTemporally("==", instance.Status.LastError.LastTransitionTime.Time))
 
+		ready := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.ConditionTypeReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("FailedToReconcile"))
+		Expect(ready.Message).To(Equal(message))
+
 		time.Sleep(2 * time.Second)
 
 		message = "error message updated"
@@ -568,4 +666,291 @@ var _ = Describe("Reconcile", func() {
 
 		Expect(instance.Status.Phase).To(Equal(lsv1alpha1.PhaseStringSucceeded))
 	})
+
+	It("should install the requested landscaper version", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test7")
+		Expect(err).ToNot(HaveOccurred())
+
+		deprecatedSince := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		componentConfig := &op.Config().LandscaperServiceComponent
+		componentConfig.Version = ""
+		componentConfig.SupportedVersions = []lssconfig.SupportedVersion{
+			{Version: "v1.0.3", DeprecatedSince: &deprecatedSince},
+			{Version: "v1.1.1"},
+			{Version: "v1.2.0"},
+		}
+
+		instance := state.GetInstance("test")
+		instance.Spec.LandscaperVersion = "v1.0.0"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.0.3"))
+
+		Expect(instance.Status.LandscaperServiceComponent).ToNot(BeNil())
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.0.3"))
+		Expect(instance.Status.LandscaperServiceComponent.Deprecated).To(BeTrue())
+		Expect(instance.Status.LandscaperServiceComponent.RemovalTime).ToNot(BeNil())
+		Expect(instance.Status.LandscaperServiceComponent.RemovalTime.Time).To(BeTemporally("==", deprecatedSince.Add(componentConfig.DeprecationPeriod.Duration)))
+
+		// the minor version v1.0 is removed from the supported versions, the instance is upgraded to the next minor version
+		componentConfig.SupportedVersions = componentConfig.SupportedVersions[1:]
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.1.1"))
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.1.1"))
+		Expect(instance.Status.LandscaperServiceComponent.Deprecated).To(BeTrue())
+		Expect(instance.Status.LandscaperServiceComponent.RemovalTime).To(BeNil())
+
+		// an instance without landscaper version gets the latest supported version
+		instance.Spec.LandscaperVersion = ""
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.2.0"))
+		Expect(instance.Status.LandscaperServiceComponent.Deprecated).To(BeFalse())
+	})
+
+	It("should hold version and landscaper configuration changes until the maintenance time window", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test7")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.1.1"))
+		landscaperConfigRaw := installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName]
+
+		// the maintenance time window begins in two hours
+		now := time.Now().UTC()
+		instance.Spec.MaintenanceTimeWindow = &lssv1alpha1.MaintenanceTimeWindow{
+			Begin: now.Add(2 * time.Hour).Format(lssv1alpha1.MaintenanceTimeWindowLayout),
+			End:   now.Add(3 * time.Hour).Format(lssv1alpha1.MaintenanceTimeWindowLayout),
+		}
+		instance.Spec.LandscaperConfiguration.Deployers = []string{"helm"}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		componentConfig := &op.Config().LandscaperServiceComponent
+		componentConfig.Version = "v1.2.0"
+		componentConfig.SupportedVersions = []lssconfig.SupportedVersion{{Version: "v1.1.1"}, {Version: "v1.2.0"}}
+
+		result, err := ctrl.Reconcile(ctx, testutils.RequestFromObject(instance))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 2*time.Hour))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.1.1"))
+		Expect(installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName]).To(Equal(landscaperConfigRaw))
+
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.1.1"))
+		Expect(instance.Status.LandscaperServiceComponent.Deprecated).To(BeTrue())
+		Expect(instance.Status.Maintenance).ToNot(BeNil())
+		Expect(instance.Status.Maintenance.PendingChanges).To(ConsistOf("landscaper version v1.1.1 -> v1.2.0", "landscaper configuration"))
+		Expect(instance.Status.Maintenance.NextMaintenanceTime).ToNot(BeNil())
+		Expect(instance.Status.Maintenance.NextMaintenanceTime.Time).To(BeTemporally("~", now.Add(2*time.Hour), time.Second))
+
+		// the maintenance time window is open
+		instance.Spec.MaintenanceTimeWindow = &lssv1alpha1.MaintenanceTimeWindow{
+			Begin: now.Add(-1 * time.Hour).Format(lssv1alpha1.MaintenanceTimeWindowLayout),
+			End:   now.Add(1 * time.Hour).Format(lssv1alpha1.MaintenanceTimeWindowLayout),
+		}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.2.0"))
+		Expect(installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName]).ToNot(Equal(landscaperConfigRaw))
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.2.0"))
+		Expect(instance.Status.Maintenance).To(BeNil())
+	})
+
+	It("should hibernate the shoot and scale down the landscaper deployments of a hibernated instance", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test8")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.Hibernation).ToNot(BeNil())
+		Expect(instance.Status.Hibernation.Hibernated).To(BeTrue())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.AutomaticReconcile).To(BeNil())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation).ToNot(BeNil())
+		Expect(shootConfig.Hibernation.Enabled).To(BeTrue())
+
+		hostingClusterNamespace := fmt.Sprintf("%s-%s", instance.Spec.TenantId, instance.Spec.ID)
+		Expect(testenv.Client.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: hostingClusterNamespace}})).To(Succeed())
+
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "landscaper",
+				Namespace: hostingClusterNamespace,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "landscaper"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "landscaper"}},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "landscaper", Image: "landscaper"}},
+					},
+				},
+			},
+		}
+		Expect(testenv.Client.Create(ctx, deployment)).To(Succeed())
+		defer func() {
+			Expect(testenv.Client.Delete(ctx, deployment)).To(Succeed())
+		}()
+
+		// the deployments are scaled down when the installation has reached a final phase
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+
+		// wake up the instance
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		instance.Spec.Hibernation.Enabled = ptr.To(false)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Hibernation.Hibernated).To(BeFalse())
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+		Expect(deployment.Annotations).ToNot(HaveKey("landscaper-service.gardener.cloud/hibernated-replicas"))
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.AutomaticReconcile).ToNot(BeNil())
+		shootConfig = &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation).To(BeNil())
+	})
+
+	It("should hibernate an instance according to its hibernation schedules", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		// the instance has been hibernated an hour ago and is woken up in an hour
+		now := time.Now().UTC()
+		start, end := now.Add(-1*time.Hour), now.Add(1*time.Hour)
+		instance.Spec.Hibernation = &lssv1alpha1.Hibernation{
+			Schedules: []lssv1alpha1.HibernationSchedule{
+				{
+					Start: fmt.Sprintf("%d %d * * *", start.Minute(), start.Hour()),
+					End:   fmt.Sprintf("%d %d * * *", end.Minute(), end.Hour()),
+				},
+			},
+		}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		result, err := ctrl.Reconcile(ctx, testutils.RequestFromObject(instance))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.Hibernation).ToNot(BeNil())
+		Expect(instance.Status.Hibernation.Hibernated).To(BeTrue())
+		Expect(instance.Status.Hibernation.NextTransitionTime).ToNot(BeNil())
+		Expect(instance.Status.Hibernation.NextTransitionTime.Time).To(BeTemporally("~", end.Truncate(time.Minute), time.Second))
+	})
+
+	It("should create the shoot in the region of the service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test9")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Region = "eu-central-1"
+		op.Config().ShootConfiguration.Provider.Type = "aws"
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Region).To(Equal("eu-west-1"))
+		Expect(shootConfig.Provider.Zone).To(Equal("eu-west-1a"))
+		Expect(instance.Status.ShootRegion).To(Equal("eu-west-1"))
+		Expect(instance.Status.ShootZone).To(Equal("eu-west-1a"))
+	})
+
+	It("should not change the region of an existing shoot when the topology of the service target config changes", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test9")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Region = "eu-central-1"
+		op.Config().ShootConfiguration.Provider.Type = "aws"
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		config := state.GetConfig("default")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		config.Spec.Topology.Region = "us-east-1"
+		config.Spec.Topology.Zone = "us-east-1a"
+		Expect(testenv.Client.Update(ctx, config)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Region).To(Equal("eu-west-1"))
+		Expect(shootConfig.Provider.Zone).To(Equal("eu-west-1a"))
+	})
 })
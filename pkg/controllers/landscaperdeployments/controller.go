@@ -11,8 +11,10 @@ import (
 
 	guuid "github.com/google/uuid"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -52,14 +54,14 @@ func defaultUniqueIdFunc() string {
 }
 
 // NewController returns a new landscaperdeployments controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log:          logger,
 		UniqueIDFunc: defaultUniqueIdFunc,
 	}
 	ctrl.ReconcileFunc = ctrl.reconcile
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
-	op := operation.NewOperation(c, scheme, config)
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
@@ -124,6 +126,15 @@ func (c *Controller) handleErrorFunc(deployment *lssv1alpha1.LandscaperDeploymen
 	return func(ctx context.Context, err error) error {
 		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(deployment).String()})
 		deployment.Status.LastError = lsserrors.TryUpdateError(deployment.Status.LastError, err)
+		if err != nil {
+			lsserrors.SetErrorCondition(&deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady, err, deployment.GetGeneration())
+
+			reason := operation.EventReasonReconcileFailed
+			if !deployment.DeletionTimestamp.IsZero() {
+				reason = operation.EventReasonDeletionFailed
+			}
+			c.EventRecorder().Event(deployment, corev1.EventTypeWarning, reason, err.Error())
+		}
 
 		if !reflect.DeepEqual(old.Status, deployment.Status) {
 			if err2 := c.Client().Status().Update(ctx, deployment); err2 != nil {
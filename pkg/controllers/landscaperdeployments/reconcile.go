@@ -6,16 +6,17 @@ package landscaperdeployments
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
-	"strings"
+	"slices"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +25,8 @@ import (
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
 	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+	"github.com/gardener/landscaper-service/pkg/operation"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
@@ -46,14 +49,22 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 		instance.Namespace = instanceRef.Namespace
 	}
 
-	_, err = kubernetes.CreateOrUpdate(ctx, c.Client(), instance, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), instance, func() error {
 		return c.mutateInstance(ctx, deployment, instance)
 	})
 
 	if err != nil {
+		if errors.Is(err, lssscheduling.ErrNoCapacity) {
+			return lsserrors.NewWrappedError(err, currOp, "NoCapacity", err.Error())
+		}
 		return lsserrors.NewWrappedError(err, currOp, "CreateUpdateInstance", err.Error())
 	}
 
+	if result == controllerutil.OperationResultCreated {
+		c.EventRecorder().Eventf(deployment, corev1.EventTypeNormal, operation.EventReasonInstanceCreated,
+			"Created instance %s", client.ObjectKeyFromObject(instance).String())
+	}
+
 	// set the instance reference for the deployment if not already set
 	if deployment.Status.InstanceRef == nil || !deployment.Status.InstanceRef.IsObject(instance) {
 		deployment.Status.InstanceRef = &lssv1alpha1.ObjectReference{
@@ -67,6 +78,10 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 	}
 
 	deployment.Status.Phase = instance.Status.Phase
+	deployment.Status.LandscaperServiceComponent = instance.Status.LandscaperServiceComponent
+	deployment.Status.Maintenance = instance.Status.Maintenance
+	deployment.Status.Hibernation = instance.Status.Hibernation
+	mirrorInstanceConditions(deployment, instance)
 
 	if deployment.IsInternalDataPlane() {
 		deployment.Status.DataPlaneType = lssv1alpha1.LandscaperDeploymentDataPlaneTypeInternal
@@ -101,6 +116,24 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 	return nil
 }
 
+// mirrorInstanceConditions copies the conditions of the instance to the landscaper deployment.
+// Conditions which the instance doesn't have anymore are removed, except the Ready condition,
+// which is set by this controller if the instance doesn't have it yet.
+func mirrorInstanceConditions(deployment *lssv1alpha1.LandscaperDeployment, instance *lssv1alpha1.Instance) {
+	deployment.Status.Conditions = slices.DeleteFunc(deployment.Status.Conditions, func(condition metav1.Condition) bool {
+		return condition.Type != lssv1alpha1.ConditionTypeReady && meta.FindStatusCondition(instance.Status.Conditions, condition.Type) == nil
+	})
+
+	for _, condition := range instance.Status.Conditions {
+		lsserrors.SetCondition(&deployment.Status.Conditions, condition.Type, condition.Status, condition.Reason, condition.Message, deployment.GetGeneration())
+	}
+
+	if meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.ConditionTypeReady) == nil {
+		lsserrors.SetCondition(&deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionFalse, "InstancePending",
+			fmt.Sprintf("instance %s has not been reconciled yet", client.ObjectKeyFromObject(instance).String()), deployment.GetGeneration())
+	}
+}
+
 // mutateInstance creates/updates the instance for a landscaper deployment
 func (c *Controller) mutateInstance(ctx context.Context, deployment *lssv1alpha1.LandscaperDeployment, instance *lssv1alpha1.Instance) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(deployment).String()},
@@ -160,82 +193,53 @@ func (c *Controller) mutateInstance(ctx context.Context, deployment *lssv1alpha1
 	instance.Spec.OIDCConfig = deployment.Spec.OIDCConfig
 	instance.Spec.HighAvailabilityConfig = deployment.Spec.HighAvailabilityConfig
 	instance.Spec.DataPlane = deployment.Spec.DataPlane
+	instance.Spec.LandscaperVersion = deployment.Spec.LandscaperVersion
+	instance.Spec.MaintenanceTimeWindow = deployment.Spec.MaintenanceTimeWindow
+	instance.Spec.Hibernation = deployment.Spec.Hibernation
 
 	c.Operation.Scheme().Default(instance)
 
 	return nil
 }
 
+// findServiceTargetConfigByScheduling determines the service target config for the landscaper deployment.
+// The explanation of the scheduling decision is written to the landscaper deployment status.
 func (c *Controller) findServiceTargetConfigByScheduling(ctx context.Context, deployment *lssv1alpha1.LandscaperDeployment) (*lssv1alpha1.ServiceTargetConfig, error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 
-	serviceTargetConfigs, err := c.getVisibleServiceTargetConfigs(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	scheduling, err := c.getSchedulingResource(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	// determine a matching service target config
-	winner, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+	winner, explanation, err := lssscheduling.ExplainScheduling(ctx, c.Client(), c.getSchedulingKey(ctx), deployment)
+	if explanation != nil {
+		deployment.Status.Scheduling = explanation
+	}
 	if err != nil {
 		log.Error(err, "unable to find service target config")
+		metrics.RecordSchedulingFailure(explanation)
+		c.EventRecorder().Eventf(deployment, corev1.EventTypeWarning, operation.EventReasonSchedulingFailed,
+			"Unable to find service target config: %s", err.Error())
 		return nil, fmt.Errorf("unable to find service target config: %w", err)
 	}
 
+	c.EventRecorder().Eventf(deployment, corev1.EventTypeNormal, operation.EventReasonScheduled,
+		"Scheduled on service target config %s", client.ObjectKeyFromObject(winner).String())
 	return winner, nil
 }
 
-func (c *Controller) getVisibleServiceTargetConfigs(ctx context.Context) ([]lssv1alpha1.ServiceTargetConfig, error) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-
-	serviceTargetConfigList := &lssv1alpha1.ServiceTargetConfigList{}
-	selectorBuilder := strings.Builder{}
-	selectorBuilder.WriteString(fmt.Sprintf("%s=true", lssv1alpha1.ServiceTargetConfigVisibleLabelName))
-
-	labelSelector, _ := labels.Parse(selectorBuilder.String())
-	listOptions := client.ListOptions{
-		LabelSelector: labelSelector,
-	}
-
-	if err := c.Client().List(ctx, serviceTargetConfigList, &listOptions); err != nil {
-		log.Error(err, "unable to list service target configs")
-		return nil, fmt.Errorf("unable to list service target configs: %w", err)
-	}
-
-	return serviceTargetConfigList.Items, nil
-}
-
-// getSchedulingResource returns the TargetScheduling resource from the core cluster.
-// Returns nil if scheduling is not configured or the scheduling resource does not exist.
-func (c *Controller) getSchedulingResource(ctx context.Context) (*lssv1alpha1.TargetScheduling, error) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
+// getSchedulingKey returns the key of the TargetScheduling resource on the core cluster.
+// Returns nil if scheduling is not configured.
+func (c *Controller) getSchedulingKey(ctx context.Context) *client.ObjectKey {
+	log, _ := logging.FromContextOrNew(ctx, nil)
 
 	schedulingConfig := c.Config().Scheduling
 	if schedulingConfig == nil {
 		log.Info("no scheduling configured")
-		return nil, nil
+		return nil
 	}
 
-	schedulingKey := client.ObjectKey{
-		Namespace: c.Config().Scheduling.Namespace,
-		Name:      c.Config().Scheduling.Name,
+	return &client.ObjectKey{
+		Namespace: schedulingConfig.Namespace,
+		Name:      schedulingConfig.Name,
 	}
-	scheduling := &lssv1alpha1.TargetScheduling{}
-	if err := c.Client().Get(ctx, schedulingKey, scheduling); err != nil {
-		if apierrors.IsNotFound(err) {
-			log.Info("no scheduling resource configured")
-			return nil, nil
-		}
-
-		log.Error(err, "unable to get scheduling object", lc.KeyResource, schedulingKey.String())
-		return nil, fmt.Errorf("unable to get scheduling object %s: %w", schedulingKey.String(), err)
-	}
-
-	return scheduling, nil
 }
 
 // getInstanceRef returns a reference to the instance owned by the deployment, or nil if there is no such instance.
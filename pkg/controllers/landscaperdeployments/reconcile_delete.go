@@ -10,12 +10,14 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
 )
 
 // handleDelete handles the deletion of a landscaper deployment.
@@ -74,6 +76,8 @@ func (c *Controller) ensureDeleteInstanceForDeployment(ctx context.Context, depl
 		if err := c.Client().Delete(ctx, instance); err != nil {
 			return false, fmt.Errorf("unable to delete instance: %w", err)
 		}
+		c.EventRecorder().Eventf(deployment, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting instance %s", instanceRef.NamespacedName().String())
 	}
 
 	return false, nil
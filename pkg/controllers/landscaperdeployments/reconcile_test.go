@@ -7,6 +7,7 @@ package landscaperdeployments_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -14,7 +15,10 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
@@ -59,6 +63,26 @@ var _ = Describe("Reconcile", func() {
 		Expect(deployment.Status.ObservedGeneration).To(Equal(int64(1)))
 	})
 
+	It("should record events for the scheduling and the creation of the instance", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(10)
+		ctrl = deploymentscontroller.NewTestActuator(*op.WithEventRecorder(recorder), logging.Discard())
+
+		deployment := state.GetDeployment("test")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Status.InstanceRef).ToNot(BeNil())
+
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal %s Scheduled on service target config %s/config3",
+			operation.EventReasonScheduled, state.Namespace))))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal %s Created instance %s",
+			operation.EventReasonInstanceCreated, deployment.Status.InstanceRef.NamespacedName().String()))))
+	})
+
 	It("should select target configuration and create instance", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
@@ -82,10 +106,44 @@ var _ = Describe("Reconcile", func() {
 		Expect(instance.Spec.ID).To(MatchRegexp("[a-f0-9]+"))
 		Expect(instance.Spec.ID).To(HaveLen(8))
 
+		Expect(deployment.Status.Scheduling).ToNot(BeNil())
+		Expect(deployment.Status.Scheduling.Unrestricted).To(BeTrue())
+		Expect(deployment.Status.Scheduling.Selected).To(Equal(&lssv1alpha1.ObjectReference{Name: config.Name, Namespace: config.Namespace}))
+		Expect(deployment.Status.Scheduling.Candidates).ToNot(BeEmpty())
+		Expect(deployment.Status.Scheduling.Candidates[0].Name).To(Equal("config3"))
+		Expect(deployment.Status.Scheduling.Candidates[0].Score).To(Equal("30.00"))
+
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
 		Expect(config.Status.InstanceRefs).To(HaveLen(1))
 		Expect(config.Status.InstanceRefs[0].Name).To(Equal(instance.Name))
 		Expect(config.Status.InstanceRefs[0].Namespace).To(Equal(instance.Namespace))
+
+		ready := meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("InstancePending"))
+
+		instance.Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ConditionTypeInstallationReady, Status: metav1.ConditionTrue, Reason: "InstallationSucceeded", LastTransitionTime: metav1.Now()},
+			{Type: lssv1alpha1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: metav1.Now()},
+		}
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(deployment.Status.Conditions, lssv1alpha1.ConditionTypeInstallationReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady)).To(BeTrue())
+
+		instance.Status.Conditions = nil
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.ConditionTypeInstallationReady)).To(BeNil())
+		ready = meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("InstancePending"))
 	})
 
 	It("should not create an instance when no target configuration is available", func() {
@@ -101,6 +159,27 @@ var _ = Describe("Reconcile", func() {
 		Expect(deployment.Status.InstanceRef).To(BeNil())
 	})
 
+	It("should report no capacity when all target configurations are full", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test7")
+		Expect(err).ToNot(HaveOccurred())
+
+		deployment := state.GetDeployment("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Status.InstanceRef).To(BeNil())
+		Expect(deployment.Status.LastError).ToNot(BeNil())
+		Expect(deployment.Status.LastError.Reason).To(Equal("NoCapacity"))
+
+		ready := meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.ConditionTypeReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("NoCapacity"))
+	})
+
 	It("should mutate an existing instance", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
@@ -122,11 +201,13 @@ var _ = Describe("Reconcile", func() {
 		deployment.Spec.LandscaperConfiguration.Deployers = []string{
 			"foo",
 		}
+		deployment.Spec.LandscaperVersion = "v1.1.1"
 		Expect(testenv.Client.Update(ctx, deployment)).To(Succeed())
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
 		err = testenv.Client.Get(ctx, types.NamespacedName{Name: deployment.Status.InstanceRef.Name, Namespace: deployment.Status.InstanceRef.Namespace}, instance)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(instance.Spec.LandscaperConfiguration).To(Equal(deployment.Spec.LandscaperConfiguration))
+		Expect(instance.Spec.LandscaperVersion).To(Equal(deployment.Spec.LandscaperVersion))
 		Expect(instance.Spec.ID).To(Equal(uid))
 	})
 
@@ -254,11 +335,17 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Get(ctx, types.NamespacedName{Name: deployment.Status.InstanceRef.Name, Namespace: deployment.Status.InstanceRef.Namespace}, instance)).To(Succeed())
 
 		instance.Status.Phase = lsv1alpha1.PhaseStringSucceeded
+		instance.Status.LandscaperServiceComponent = &lssv1alpha1.LandscaperServiceComponent{
+			Name:       "github.com/gardener/landscaper-service/landscaper-instance",
+			Version:    "v1.1.1",
+			Deprecated: true,
+		}
 		Expect(testenv.Client.Status().Update(ctx, instance))
 
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
 
 		Expect(deployment.Status.Phase).To(Equal(lsv1alpha1.PhaseStringSucceeded))
+		Expect(deployment.Status.LandscaperServiceComponent).To(Equal(instance.Status.LandscaperServiceComponent))
 	})
 })
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ErrNoCapacity is returned if none of the candidate ServiceTargetConfigs has enough capacity left.
+var ErrNoCapacity = errors.New("no capacity")
+
+// filterByCapacity returns the ServiceTargetConfigs which have enough capacity left to host the LandscaperDeployment.
+// The other ServiceTargetConfigs are recorded as filtered out in the explanation.
+// The instance count of a ServiceTargetConfig is taken from its instance references,
+// the cpu and memory usage is the sum of the resource requests of the instances which reference it.
+func filterByCapacity(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+	explanation *lssv1alpha1.SchedulingExplanation,
+) ([]*lssv1alpha1.ServiceTargetConfig, error) {
+
+	var requested *lssv1alpha1.Resources
+	if deployment != nil {
+		requested = deployment.Spec.LandscaperConfiguration.Resources
+	}
+	requestedCPU, requestedMemory, err := parseResources(requested)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource requests of landscaper deployment: %w", err)
+	}
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	for _, config := range configs {
+		hasCapacity, message, err := checkCapacity(config, requestedCPU, requestedMemory, instances)
+		if err != nil {
+			return nil, err
+		}
+		if !hasCapacity {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+				Reason:  lssv1alpha1.SchedulingReasonNoCapacity,
+				Message: message,
+			})
+			continue
+		}
+		result = append(result, config)
+	}
+	return result, nil
+}
+
+// checkCapacity checks whether the ServiceTargetConfig can host an additional instance with the given resource requests.
+// If not, the returned message describes which limit would be exceeded.
+func checkCapacity(
+	config *lssv1alpha1.ServiceTargetConfig,
+	requestedCPU, requestedMemory resource.Quantity,
+	instances []lssv1alpha1.Instance,
+) (bool, string, error) {
+
+	if config.Spec.MaxInstances != nil && int64(len(config.Status.InstanceRefs)) >= *config.Spec.MaxInstances {
+		return false, fmt.Sprintf("maximum number of instances %d reached", *config.Spec.MaxInstances), nil
+	}
+
+	budget := config.Spec.ResourceBudget
+	if budget == nil {
+		return true, "", nil
+	}
+
+	usedCPU, usedMemory := resource.Quantity{}, resource.Quantity{}
+	for i := range instances {
+		instance := &instances[i]
+		if instance.Spec.ServiceTargetConfigRef.Name != config.Name || instance.Spec.ServiceTargetConfigRef.Namespace != config.Namespace {
+			continue
+		}
+		// the requests of existing instances can't be corrected here, invalid values are not counted
+		cpu, memory, err := parseResources(instance.Spec.LandscaperConfiguration.Resources)
+		if err != nil {
+			continue
+		}
+		usedCPU.Add(cpu)
+		usedMemory.Add(memory)
+	}
+
+	if len(budget.CPU) > 0 {
+		ok, err := fitsInBudget(budget.CPU, usedCPU, requestedCPU)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid cpu budget of service target config %s/%s: %w", config.Namespace, config.Name, err)
+		}
+		if !ok {
+			return false, fmt.Sprintf("cpu budget %s exhausted, %s in use", budget.CPU, usedCPU.String()), nil
+		}
+	}
+
+	if len(budget.Memory) > 0 {
+		ok, err := fitsInBudget(budget.Memory, usedMemory, requestedMemory)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid memory budget of service target config %s/%s: %w", config.Namespace, config.Name, err)
+		}
+		if !ok {
+			return false, fmt.Sprintf("memory budget %s exhausted, %s in use", budget.Memory, usedMemory.String()), nil
+		}
+	}
+
+	return true, "", nil
+}
+
+func fitsInBudget(budget string, used, requested resource.Quantity) (bool, error) {
+	limit, err := resource.ParseQuantity(budget)
+	if err != nil {
+		return false, err
+	}
+	used.Add(requested)
+	return used.Cmp(limit) <= 0, nil
+}
+
+// parseResources returns the requested cpu and memory. Requests which are not set are returned as zero.
+func parseResources(resources *lssv1alpha1.Resources) (cpu, memory resource.Quantity, err error) {
+	if resources == nil {
+		return
+	}
+	if len(resources.Requests.CPU) > 0 {
+		if cpu, err = resource.ParseQuantity(resources.Requests.CPU); err != nil {
+			return
+		}
+	}
+	if len(resources.Requests.Memory) > 0 {
+		if memory, err = resource.ParseQuantity(resources.Requests.Memory); err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ExplainScheduling reads the visible ServiceTargetConfigs, the TargetScheduling, the instances and the LandscaperDeployments from the cluster
+// and explains on which ServiceTargetConfig the LandscaperDeployment is scheduled.
+// If the scheduling key is nil or the TargetScheduling does not exist, no scheduling rules are applied.
+// Nothing is written to the cluster, so that this can also be used for a dry-run.
+func ExplainScheduling(
+	ctx context.Context,
+	kubeClient client.Client,
+	schedulingKey *client.ObjectKey,
+	deployment *lssv1alpha1.LandscaperDeployment,
+) (*lssv1alpha1.ServiceTargetConfig, *lssv1alpha1.SchedulingExplanation, error) {
+
+	serviceTargetConfigs, err := GetVisibleServiceTargetConfigs(ctx, kubeClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheduling, err := GetTargetScheduling(ctx, kubeClient, schedulingKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the instances are needed to compute the resource usage of the service target configs
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := kubeClient.List(ctx, instanceList); err != nil {
+		return nil, nil, fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	// the landscaper deployments are needed to spread instances by label
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := kubeClient.List(ctx, deploymentList); err != nil {
+		return nil, nil, fmt.Errorf("unable to list landscaper deployments: %w", err)
+	}
+
+	return ExplainServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, instanceList.Items, deploymentList.Items)
+}
+
+// GetVisibleServiceTargetConfigs returns the ServiceTargetConfigs which are labeled as visible.
+func GetVisibleServiceTargetConfigs(ctx context.Context, kubeClient client.Client) ([]lssv1alpha1.ServiceTargetConfig, error) {
+	serviceTargetConfigList := &lssv1alpha1.ServiceTargetConfigList{}
+	listOptions := client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true"}),
+	}
+
+	if err := kubeClient.List(ctx, serviceTargetConfigList, &listOptions); err != nil {
+		return nil, fmt.Errorf("unable to list service target configs: %w", err)
+	}
+
+	return serviceTargetConfigList.Items, nil
+}
+
+// GetTargetScheduling returns the TargetScheduling with the given key.
+// Returns nil if the key is nil or the TargetScheduling does not exist.
+func GetTargetScheduling(ctx context.Context, kubeClient client.Client, schedulingKey *client.ObjectKey) (*lssv1alpha1.TargetScheduling, error) {
+	if schedulingKey == nil {
+		return nil, nil
+	}
+
+	scheduling := &lssv1alpha1.TargetScheduling{}
+	if err := kubeClient.Get(ctx, *schedulingKey, scheduling); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get scheduling object %s: %w", schedulingKey.String(), err)
+	}
+
+	return scheduling, nil
+}
@@ -7,58 +7,106 @@ package scheduling
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
-	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
+// FindServiceTargetConfig selects the ServiceTargetConfig on which the LandscaperDeployment is scheduled.
 func FindServiceTargetConfig(
 	scheduling *lssv1alpha1.TargetScheduling,
 	deployment *lssv1alpha1.LandscaperDeployment,
-	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) (*lssv1alpha1.ServiceTargetConfig, error) {
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance,
+	deployments []lssv1alpha1.LandscaperDeployment) (*lssv1alpha1.ServiceTargetConfig, error) {
+
+	config, _, err := ExplainServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, instances, deployments)
+	return config, err
+}
+
+// ExplainServiceTargetConfig selects the ServiceTargetConfig like FindServiceTargetConfig and additionally
+// returns an explanation which rules matched, which ServiceTargetConfigs have been excluded and how the candidates were ranked.
+// The explanation is also returned if no ServiceTargetConfig could be selected, unless the scheduling rules are invalid.
+// The LandscaperDeployments are only needed to determine the label values of the instances for a spread policy by label.
+func ExplainServiceTargetConfig(
+	scheduling *lssv1alpha1.TargetScheduling,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance,
+	deployments []lssv1alpha1.LandscaperDeployment) (*lssv1alpha1.ServiceTargetConfig, *lssv1alpha1.SchedulingExplanation, error) {
+
+	explanation := &lssv1alpha1.SchedulingExplanation{}
 
 	// Find the ServiceTargetConfigs which match the deployment according to the scheduling rules.
 	configRefs := make([]lssv1alpha1.ObjectReference, 0)
 	if scheduling != nil {
 		var err error
-		configRefs, err = evaluateRules(scheduling, deployment)
+		configRefs, explanation.MatchedRules, err = evaluateRules(scheduling, deployment)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	// If scheduling is not configured, or no scheduling rules match, there are no configRefs so far.
 	// In this case, we continue with the unrestricted ServiceTargetConfigs.
 	if len(configRefs) == 0 {
-		configRefs = getUnrestricted(serviceTargetConfigs)
+		explanation.Unrestricted = true
+		configRefs = getUnrestricted(serviceTargetConfigs, explanation)
 	}
 
-	// Remove duplicates and not existing ServiceTargetConfigs.
-	configs := convertAndFilter(configRefs, serviceTargetConfigs)
+	// Remove duplicates, not existing and not ready ServiceTargetConfigs.
+	configs := convertAndFilter(configRefs, serviceTargetConfigs, explanation)
+
+	// Remove the ServiceTargetConfigs which don't fulfill the required topology affinity.
+	configs, err := filterByTopology(configs, deployment, explanation)
+	if err != nil {
+		return nil, nil, err
+	}
 	if len(configs) == 0 {
 		err := fmt.Errorf("no service target config available")
-		return nil, err
+		return nil, explanation, err
+	}
+
+	// Apply the spread policy of the matched rules.
+	var spread *spreading
+	if scheduling != nil {
+		spread = newSpreading(getSpreadPolicy(scheduling, explanation.MatchedRules), deployment, instances, deployments)
+		if spread != nil {
+			explanation.Spread = spread.policy
+		}
 	}
 
 	// Pick one of the ServiceTargetConfigs.
-	return PickServiceTargetConfig(configs)
+	config, err := pickServiceTargetConfig(configs, deployment, instances, spread, explanation)
+	if err != nil {
+		return nil, explanation, err
+	}
+
+	explanation.Selected = &lssv1alpha1.ObjectReference{
+		Name:      config.Name,
+		Namespace: config.Namespace,
+	}
+	return config, explanation, nil
 }
 
 func evaluateRules(
 	scheduling *lssv1alpha1.TargetScheduling,
 	deployment *lssv1alpha1.LandscaperDeployment,
-) ([]lssv1alpha1.ObjectReference, error) {
+) ([]lssv1alpha1.ObjectReference, []lssv1alpha1.MatchedSchedulingRule, error) {
 
 	var highestFoundPrio int64 = -1
 	candidates := make([]lssv1alpha1.ObjectReference, 0)
+	matchedRules := make([]lssv1alpha1.MatchedSchedulingRule, 0)
 
 	for i := range scheduling.Spec.Rules {
 		rule := &scheduling.Spec.Rules[i]
 
 		if len(rule.ServiceTargetConfigs) == 0 {
-			return nil, fmt.Errorf("rule must contain at least one service target config")
+			return nil, nil, fmt.Errorf("rule must contain at least one service target config")
 		}
 		if rule.Priority < 0 {
-			return nil, fmt.Errorf("rule priority must not be negative")
+			return nil, nil, fmt.Errorf("rule priority must not be negative")
 		}
 
 		if rule.Priority < highestFoundPrio {
@@ -68,7 +116,7 @@ func evaluateRules(
 
 		match, err := EvaluateSelectorList(rule.Selector, deployment)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if !match {
@@ -80,49 +128,112 @@ func evaluateRules(
 			// rule has higher prio: replace candidates
 			highestFoundPrio = rule.Priority
 			candidates = rule.ServiceTargetConfigs
+			matchedRules = matchedRules[:0]
 		} else {
 			// rule has same prio: append candidates
 			candidates = append(candidates, rule.ServiceTargetConfigs...)
 		}
+		matchedRules = append(matchedRules, lssv1alpha1.MatchedSchedulingRule{Index: i, Priority: rule.Priority})
 	}
 
-	return candidates, nil
+	return candidates, matchedRules, nil
 }
 
-func getUnrestricted(serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) []lssv1alpha1.ObjectReference {
+// getUnrestricted returns references to the unrestricted ServiceTargetConfigs.
+// The restricted ServiceTargetConfigs are recorded as filtered out in the explanation.
+func getUnrestricted(
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	explanation *lssv1alpha1.SchedulingExplanation,
+) []lssv1alpha1.ObjectReference {
 	result := make([]lssv1alpha1.ObjectReference, 0)
 
 	for i := range serviceTargetConfigs {
 		serviceTargetConfig := &serviceTargetConfigs[i]
-		if !serviceTargetConfig.Spec.Restricted {
-			ref := lssv1alpha1.ObjectReference{
-				Name:      serviceTargetConfig.Name,
-				Namespace: serviceTargetConfig.Namespace,
-			}
-			result = append(result, ref)
+		ref := lssv1alpha1.ObjectReference{
+			Name:      serviceTargetConfig.Name,
+			Namespace: serviceTargetConfig.Namespace,
 		}
+		if serviceTargetConfig.Spec.Restricted {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: ref,
+				Reason:          lssv1alpha1.SchedulingReasonRestricted,
+				Message:         "no scheduling rule matched and the service target config is restricted",
+			})
+			continue
+		}
+		result = append(result, ref)
 	}
 
 	return result
 }
 
 // convertAndFilter converts ObjectReferences to ServiceTargetConfigs.
-// It skips duplicates and ObjectReferences for which there exists no ServiceTargetConfig.
+// It skips duplicates, ObjectReferences for which there exists no ServiceTargetConfig,
+// cordoned ServiceTargetConfigs, and ServiceTargetConfigs whose target cluster has been probed as not ready.
+// The skipped ServiceTargetConfigs, except for duplicates, are recorded as filtered out in the explanation.
 func convertAndFilter(
 	configRefs []lssv1alpha1.ObjectReference,
 	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	explanation *lssv1alpha1.SchedulingExplanation,
 ) []*lssv1alpha1.ServiceTargetConfig {
 
-	m := map[lssv1alpha1.ObjectReference]*lssv1alpha1.ServiceTargetConfig{}
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configRefs))
+	seen := sets.New[lssv1alpha1.ObjectReference]()
 
 	for _, ref := range configRefs {
-		for k := range serviceTargetConfigs {
-			serviceTargetConfig := &serviceTargetConfigs[k]
-			if ref.Name == serviceTargetConfig.Name && ref.Namespace == serviceTargetConfig.Namespace {
-				m[ref] = serviceTargetConfig
+		if seen.Has(ref) {
+			continue
+		}
+		seen.Insert(ref)
+
+		serviceTargetConfig := findServiceTargetConfig(ref, serviceTargetConfigs)
+		if serviceTargetConfig == nil {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: ref,
+				Reason:          lssv1alpha1.SchedulingReasonNotFound,
+				Message:         "service target config does not exist or is not visible",
+			})
+			continue
+		}
+
+		if serviceTargetConfig.IsCordoned() {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: ref,
+				Reason:          lssv1alpha1.SchedulingReasonCordoned,
+				Message:         fmt.Sprintf("service target config is cordoned: %s", serviceTargetConfig.Spec.Cordon.Reason),
+			})
+			continue
+		}
+
+		if serviceTargetConfig.IsNotReady() {
+			message := "target cluster has been probed as not ready"
+			if condition := meta.FindStatusCondition(serviceTargetConfig.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady); condition != nil && len(condition.Message) > 0 {
+				message = condition.Message
 			}
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: ref,
+				Reason:          lssv1alpha1.SchedulingReasonNotReady,
+				Message:         message,
+			})
+			continue
 		}
+
+		result = append(result, serviceTargetConfig)
 	}
 
-	return utils.GetMapValues(m)
+	return result
+}
+
+func findServiceTargetConfig(
+	ref lssv1alpha1.ObjectReference,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+) *lssv1alpha1.ServiceTargetConfig {
+
+	for k := range serviceTargetConfigs {
+		serviceTargetConfig := &serviceTargetConfigs[k]
+		if ref.Name == serviceTargetConfig.Name && ref.Namespace == serviceTargetConfig.Namespace {
+			return serviceTargetConfig
+		}
+	}
+	return nil
 }
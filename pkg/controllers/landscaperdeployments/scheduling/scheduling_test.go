@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
@@ -101,7 +102,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -156,7 +157,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -189,7 +190,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -225,7 +226,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -241,7 +242,7 @@ var _ = Describe("Scheduling", func() {
 
 		deployment := buildLandscaperDeployment(tenant1, nil)
 
-		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -274,7 +275,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -293,8 +294,465 @@ var _ = Describe("Scheduling", func() {
 
 		scheduling := &lssv1alpha1.TargetScheduling{}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
+
+	It("should skip service target configs which are not ready", func() {
+		// The scheduling contains one rule with three ServiceTargetConfigs.
+		// The one with the highest prio has been probed as not ready. Therefore, it must not be selected.
+
+		notReady := buildServiceTargetConfig(config2, 30, false) // highest prio, but not ready
+		notReady.Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionFalse},
+		}
+		ready := buildServiceTargetConfig(config3, 20, false)
+		ready.Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionTrue},
+		}
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, false),
+			*notReady,
+			*ready,
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+							{Name: config2, Namespace: namespace1},
+							{Name: config3, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config3))
+	})
+
+	It("should fail if no service target config is ready", func() {
+		notReady := buildServiceTargetConfig(config1, 10, false)
+		notReady.Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionFalse},
+		}
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{*notReady}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		_, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should explain the selection of a service target config", func() {
+		// The scheduling contains two matching rules with the same prio and one matching rule with a lower prio.
+		// The candidates of the two rules with the highest prio are filtered and ranked.
+
+		notReady := buildServiceTargetConfig(config2, 30, false)
+		notReady.Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionFalse, Message: "target cluster unreachable"},
+		}
+		full := buildServiceTargetConfig(config3, 20, false)
+		full.Spec.MaxInstances = ptr.To[int64](1)
+		full.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "test-instance", Namespace: namespace1}}
+		used := buildServiceTargetConfig(config1, 30, false)
+		used.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "test-instance", Namespace: namespace1}}
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{*used, *notReady, *full}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 2,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: "test-config-4", Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+							{Name: config2, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+							{Name: config3, Namespace: namespace1},
+							{Name: "test-config-5", Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		config, explanation, err := lssscheduling.ExplainServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+
+		Expect(explanation.Unrestricted).To(BeFalse())
+		Expect(explanation.MatchedRules).To(Equal([]lssv1alpha1.MatchedSchedulingRule{
+			{Index: 1, Priority: 4},
+			{Index: 2, Priority: 4},
+		}))
+		Expect(explanation.FilteredOut).To(Equal([]lssv1alpha1.FilteredServiceTargetConfig{
+			{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1},
+				Reason:          lssv1alpha1.SchedulingReasonNotReady,
+				Message:         "target cluster unreachable",
+			},
+			{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: "test-config-5", Namespace: namespace1},
+				Reason:          lssv1alpha1.SchedulingReasonNotFound,
+				Message:         "service target config does not exist or is not visible",
+			},
+			{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: config3, Namespace: namespace1},
+				Reason:          lssv1alpha1.SchedulingReasonNoCapacity,
+				Message:         "maximum number of instances 1 reached",
+			},
+		}))
+		Expect(explanation.Candidates).To(Equal([]lssv1alpha1.SchedulingCandidate{
+			{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: config1, Namespace: namespace1},
+				Priority:        30,
+				InstanceCount:   1,
+				Score:           "15.00",
+			},
+		}))
+		Expect(explanation.Selected).To(Equal(&lssv1alpha1.ObjectReference{Name: config1, Namespace: namespace1}))
+	})
+
+	It("should explain why restricted service target configs are not selected", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, true),
+			*buildServiceTargetConfig(config2, 10, false),
+			*buildServiceTargetConfig(config3, 30, false),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config3))
+
+		Expect(explanation.Unrestricted).To(BeTrue())
+		Expect(explanation.MatchedRules).To(BeEmpty())
+		Expect(explanation.FilteredOut).To(HaveLen(1))
+		Expect(explanation.FilteredOut[0].Name).To(Equal(config1))
+		Expect(explanation.FilteredOut[0].Reason).To(Equal(lssv1alpha1.SchedulingReasonRestricted))
+		Expect(explanation.Candidates).To(HaveLen(2))
+		Expect(explanation.Candidates[0].Name).To(Equal(config3))
+		Expect(explanation.Candidates[0].Score).To(Equal("30.00"))
+		Expect(explanation.Candidates[1].Name).To(Equal(config2))
+		Expect(explanation.Candidates[1].Score).To(Equal("10.00"))
+	})
+
+	It("should not select cordoned service target configs", func() {
+		cordoned := buildServiceTargetConfig(config2, 30, false) // highest prio, but cordoned
+		cordoned.Spec.Cordon = &lssv1alpha1.Cordon{Reason: "cluster upgrade"}
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, false),
+			*cordoned,
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+
+		Expect(explanation.FilteredOut).To(Equal([]lssv1alpha1.FilteredServiceTargetConfig{
+			{
+				ObjectReference: lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1},
+				Reason:          lssv1alpha1.SchedulingReasonCordoned,
+				Message:         "service target config is cordoned: cluster upgrade",
+			},
+		}))
+	})
+
+	It("should return the explanation if no service target config has capacity left", func() {
+		full := buildServiceTargetConfig(config1, 10, false)
+		full.Spec.MaxInstances = ptr.To[int64](0)
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, []lssv1alpha1.ServiceTargetConfig{*full}, nil, nil)
+		Expect(err).To(MatchError(lssscheduling.ErrNoCapacity))
+		Expect(config).To(BeNil())
+		Expect(explanation).NotTo(BeNil())
+		Expect(explanation.Selected).To(BeNil())
+		Expect(explanation.Candidates).To(BeEmpty())
+		Expect(explanation.FilteredOut).To(HaveLen(1))
+		Expect(explanation.FilteredOut[0].Reason).To(Equal(lssv1alpha1.SchedulingReasonNoCapacity))
+	})
+
+	Context("Spread", func() {
+
+		buildInstance := func(name, tenantID, configName string, owner *lssv1alpha1.LandscaperDeployment) lssv1alpha1.Instance {
+			instance := lssv1alpha1.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace1,
+				},
+				Spec: lssv1alpha1.InstanceSpec{
+					TenantId:               tenantID,
+					ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: configName, Namespace: namespace1},
+				},
+			}
+			if owner != nil {
+				instance.OwnerReferences = []metav1.OwnerReference{
+					{APIVersion: lssv1alpha1.SchemeGroupVersion.String(), Kind: "LandscaperDeployment", Name: owner.Name, Controller: ptr.To(true)},
+				}
+			}
+			return instance
+		}
+
+		buildScheduling := func(spread *lssv1alpha1.SpreadPolicy) *lssv1alpha1.TargetScheduling {
+			return &lssv1alpha1.TargetScheduling{
+				Spec: lssv1alpha1.TargetSchedulingSpec{
+					Rules: []lssv1alpha1.SchedulingRule{
+						{
+							Priority: 4,
+							ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+								{Name: config1, Namespace: namespace1},
+								{Name: config2, Namespace: namespace1},
+							},
+							Selector: []lssv1alpha1.Selector{
+								{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+							},
+							Spread: spread,
+						},
+					},
+				},
+			}
+		}
+
+		It("should prefer the service target config with fewer instances of the same tenant", func() {
+			// config1 has the higher priority, but hosts two instances of the tenant.
+			// Without spread it would be selected: 30/3 > 10/2
+
+			config1Obj := buildServiceTargetConfig(config1, 30, false)
+			config1Obj.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "i1"}, {Name: "i2"}}
+			config2Obj := buildServiceTargetConfig(config2, 10, false)
+			config2Obj.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "i3"}}
+			serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{*config1Obj, *config2Obj}
+
+			instances := []lssv1alpha1.Instance{
+				buildInstance("i1", tenant1, config1, nil),
+				buildInstance("i2", tenant1, config1, nil),
+				buildInstance("i3", tenant2, config2, nil),
+			}
+
+			deployment := buildLandscaperDeployment(tenant1, nil)
+
+			config, err := lssscheduling.FindServiceTargetConfig(buildScheduling(nil), deployment, serviceTargetConfigs, instances, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(buildScheduling(&lssv1alpha1.SpreadPolicy{}), deployment, serviceTargetConfigs, instances, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config2))
+			Expect(explanation.Spread).ToNot(BeNil())
+			Expect(explanation.Candidates).To(HaveLen(2))
+			Expect(explanation.Candidates[0].Name).To(Equal(config2))
+			Expect(explanation.Candidates[0].SpreadCount).To(Equal(ptr.To(0)))
+			Expect(explanation.Candidates[0].Score).To(Equal("5.00"))
+			Expect(explanation.Candidates[1].Name).To(Equal(config1))
+			Expect(explanation.Candidates[1].SpreadCount).To(Equal(ptr.To(2)))
+			Expect(explanation.Candidates[1].Score).To(Equal("3.33"))
+		})
+
+		It("should exclude service target configs which violate a hard spread policy", func() {
+			// config1 hosts two instances with the same label value, config2 hosts one.
+			// A soft spread would still select config1: 90/(3*3) > 10/(3*2)
+
+			labels := map[string]string{key1: value1}
+			owner1 := buildLandscaperDeployment(tenant1, labels)
+			owner1.Name = "d1"
+			owner2 := buildLandscaperDeployment(tenant2, labels)
+			owner2.Name = "d2"
+			owner3 := buildLandscaperDeployment(tenant2, labels)
+			owner3.Name = "d3"
+			owner4 := buildLandscaperDeployment(tenant1, map[string]string{key1: value2})
+			owner4.Name = "d4"
+			deployments := []lssv1alpha1.LandscaperDeployment{*owner1, *owner2, *owner3, *owner4}
+			for i := range deployments {
+				deployments[i].Namespace = namespace1
+			}
+
+			config1Obj := buildServiceTargetConfig(config1, 90, false)
+			config1Obj.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "i1"}, {Name: "i2"}}
+			config2Obj := buildServiceTargetConfig(config2, 10, false)
+			config2Obj.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: "i3"}, {Name: "i4"}}
+			serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{*config1Obj, *config2Obj}
+
+			instances := []lssv1alpha1.Instance{
+				buildInstance("i1", tenant1, config1, owner1),
+				buildInstance("i2", tenant2, config1, owner2),
+				buildInstance("i3", tenant2, config2, owner3),
+				buildInstance("i4", tenant1, config2, owner4),
+			}
+
+			deployment := buildLandscaperDeployment(tenant1, labels)
+
+			config, err := lssscheduling.FindServiceTargetConfig(buildScheduling(&lssv1alpha1.SpreadPolicy{LabelName: key1}), deployment, serviceTargetConfigs, instances, deployments)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+
+			spread := &lssv1alpha1.SpreadPolicy{Mode: lssv1alpha1.SpreadModeHard, LabelName: key1}
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(buildScheduling(spread), deployment, serviceTargetConfigs, instances, deployments)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config2))
+			Expect(explanation.FilteredOut).To(HaveLen(1))
+			Expect(explanation.FilteredOut[0].Name).To(Equal(config1))
+			Expect(explanation.FilteredOut[0].Reason).To(Equal(lssv1alpha1.SchedulingReasonSpreadConstraint))
+			Expect(explanation.Candidates).To(HaveLen(1))
+			Expect(explanation.Candidates[0].SpreadCount).To(Equal(ptr.To(1)))
+
+			// with a maximum skew of 2, config1 is allowed again
+			spread.MaxSkew = ptr.To[int64](2)
+			config, err = lssscheduling.FindServiceTargetConfig(buildScheduling(spread), deployment, serviceTargetConfigs, instances, deployments)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+		})
+
+		It("should not spread by label if the landscaper deployment does not have the label", func() {
+			serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+				*buildServiceTargetConfig(config1, 30, false),
+				*buildServiceTargetConfig(config2, 10, false),
+			}
+
+			deployment := buildLandscaperDeployment(tenant1, nil)
+
+			spread := &lssv1alpha1.SpreadPolicy{Mode: lssv1alpha1.SpreadModeHard, LabelName: key1}
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(buildScheduling(spread), deployment, serviceTargetConfigs, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+			Expect(explanation.Spread).To(BeNil())
+			Expect(explanation.Candidates[0].SpreadCount).To(BeNil())
+		})
+	})
+
+	Context("Topology", func() {
+
+		buildTopologyConfigs := func() []lssv1alpha1.ServiceTargetConfig {
+			eu := buildServiceTargetConfig(config1, 10, false)
+			eu.Spec.Topology = &lssv1alpha1.Topology{Region: "eu-west-1", Provider: "aws"}
+			us := buildServiceTargetConfig(config2, 30, false)
+			us.Spec.Topology = &lssv1alpha1.Topology{Region: "us-east-1", Provider: "aws"}
+			unknown := buildServiceTargetConfig(config3, 20, false)
+			return []lssv1alpha1.ServiceTargetConfig{*eu, *us, *unknown}
+		}
+
+		It("should exclude service target configs which don't fulfill the required topology affinity", func() {
+			deployment := buildLandscaperDeployment(tenant1, nil)
+			deployment.Spec.TopologyAffinity = &lssv1alpha1.TopologyAffinity{
+				Required: []lssv1alpha1.TopologyRequirement{
+					{Key: lssv1alpha1.TopologyKeyRegion, Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"eu-"}},
+				},
+			}
+
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+			Expect(explanation.FilteredOut).To(HaveLen(2))
+			Expect(explanation.FilteredOut[0].Name).To(Equal(config2))
+			Expect(explanation.FilteredOut[0].Reason).To(Equal(lssv1alpha1.SchedulingReasonTopologyAffinity))
+			Expect(explanation.FilteredOut[1].Name).To(Equal(config3))
+			Expect(explanation.FilteredOut[1].Reason).To(Equal(lssv1alpha1.SchedulingReasonTopologyAffinity))
+		})
+
+		It("should match service target configs without topology with the operator NotIn", func() {
+			deployment := buildLandscaperDeployment(tenant1, nil)
+			deployment.Spec.TopologyAffinity = &lssv1alpha1.TopologyAffinity{
+				Required: []lssv1alpha1.TopologyRequirement{
+					{Key: lssv1alpha1.TopologyKeyRegion, Operator: lssv1alpha1.SelectorOpNotIn, Values: []string{"us-east-1"}},
+				},
+			}
+
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config3))
+			Expect(explanation.Candidates).To(HaveLen(2))
+		})
+
+		It("should fail if no service target config fulfills the required topology affinity", func() {
+			deployment := buildLandscaperDeployment(tenant1, nil)
+			deployment.Spec.TopologyAffinity = &lssv1alpha1.TopologyAffinity{
+				Required: []lssv1alpha1.TopologyRequirement{
+					{Key: lssv1alpha1.TopologyKeyProvider, Operator: lssv1alpha1.SelectorOpIn, Values: []string{"gcp"}},
+				},
+			}
+
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(config).To(BeNil())
+			Expect(explanation.FilteredOut).To(HaveLen(3))
+		})
+
+		It("should increase the score of service target configs which fulfill a preferred topology affinity", func() {
+			// without affinity, the config with the highest priority would be selected: 30 > 20 > 10
+			// with affinity weight 100 for eu and 50 for aws: 10*(1+1.5)=25 < 30*(1+0.5)=45
+			// with affinity weight 100 for eu and 100 for eu-west-1: 10*(1+2)=30 > 20
+
+			deployment := buildLandscaperDeployment(tenant1, nil)
+
+			config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config2))
+
+			deployment.Spec.TopologyAffinity = &lssv1alpha1.TopologyAffinity{
+				Preferred: []lssv1alpha1.WeightedTopologyRequirement{
+					{Weight: 100, TopologyRequirement: lssv1alpha1.TopologyRequirement{Key: lssv1alpha1.TopologyKeyRegion, Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"eu-"}}},
+					{Weight: 50, TopologyRequirement: lssv1alpha1.TopologyRequirement{Key: lssv1alpha1.TopologyKeyProvider, Operator: lssv1alpha1.SelectorOpIn, Values: []string{"aws"}}},
+				},
+			}
+
+			config, explanation, err := lssscheduling.ExplainServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config2))
+			Expect(explanation.Candidates).To(HaveLen(3))
+			Expect(explanation.Candidates[0].AffinityWeight).To(Equal(int64(50)))
+			Expect(explanation.Candidates[0].Score).To(Equal("45.00"))
+			Expect(explanation.Candidates[1].Name).To(Equal(config1))
+			Expect(explanation.Candidates[1].AffinityWeight).To(Equal(int64(150)))
+			Expect(explanation.Candidates[1].Score).To(Equal("25.00"))
+
+			deployment.Spec.TopologyAffinity.Preferred[1] = lssv1alpha1.WeightedTopologyRequirement{
+				Weight: 100, TopologyRequirement: lssv1alpha1.TopologyRequirement{Key: lssv1alpha1.TopologyKeyRegion, Operator: lssv1alpha1.SelectorOpIn, Values: []string{"eu-west-1"}},
+			}
+
+			config, err = lssscheduling.FindServiceTargetConfig(nil, deployment, buildTopologyConfigs(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Name).To(Equal(config1))
+		})
+	})
 })
@@ -6,9 +6,12 @@ package scheduling
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
-	"github.com/gardener/landscaper-service/pkg/utils"
+	"github.com/gardener/landscaper-service/pkg/apis/expressions"
 )
 
 func EvaluateSelectorList(selectors []v1alpha1.Selector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
@@ -31,6 +34,10 @@ func EvaluateSelector(selector *v1alpha1.Selector, deployment *v1alpha1.Landscap
 		return evaluateTenantSelector(selector.MatchTenant, deployment)
 	} else if selector.MatchLabel != nil {
 		return evaluateLabelSelector(selector.MatchLabel, deployment)
+	} else if selector.MatchPurpose != nil {
+		return evaluatePurposeSelector(selector.MatchPurpose, deployment)
+	} else if len(selector.MatchExpression) > 0 {
+		return evaluateExpressionSelector(selector.MatchExpression, deployment)
 	} else if len(selector.Or) > 0 {
 		return evaluateOr(selector.Or, deployment)
 	} else if len(selector.And) > 0 {
@@ -43,12 +50,75 @@ func EvaluateSelector(selector *v1alpha1.Selector, deployment *v1alpha1.Landscap
 }
 
 func evaluateTenantSelector(selector *v1alpha1.TenantSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
-	return selector.ID == deployment.Spec.TenantId, nil
+	return evaluateValue(selector.Operator, selector.ID, selector.Values, deployment.Spec.TenantId)
 }
 
-// TODO Do we want to support label selectors which check only the existence of the label, ignoring the value?
 func evaluateLabelSelector(labelSelector *v1alpha1.LabelSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
-	return utils.HasLabelWithValue(&deployment.ObjectMeta, labelSelector.Name, labelSelector.Value), nil
+	value, exists := deployment.Labels[labelSelector.Name]
+
+	switch labelSelector.Operator {
+	case v1alpha1.SelectorOpExists:
+		return exists, nil
+	case v1alpha1.SelectorOpDoesNotExist:
+		return !exists, nil
+	case v1alpha1.SelectorOpNotIn:
+		if !exists {
+			return true, nil
+		}
+	default:
+		if !exists {
+			return false, nil
+		}
+	}
+
+	return evaluateValue(labelSelector.Operator, labelSelector.Value, labelSelector.Values, value)
+}
+
+func evaluatePurposeSelector(selector *v1alpha1.PurposeSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
+	return evaluateValue(selector.Operator, selector.Value, selector.Values, deployment.Spec.Purpose)
+}
+
+func evaluateExpressionSelector(expression string, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
+	match, err := expressions.EvaluateSelectorExpression(expression, deployment)
+	if err != nil {
+		return false, fmt.Errorf("cannot evaluate selector: %w", err)
+	}
+	return match, nil
+}
+
+// evaluateValue compares a value of a LandscaperDeployment with the values of a selector according to the operator.
+// Without operator, the value must be equal to the exact value of the selector.
+func evaluateValue(operator v1alpha1.SelectorOperator, exactValue string, values []string, value string) (bool, error) {
+	switch operator {
+	case "":
+		return value == exactValue, nil
+	case v1alpha1.SelectorOpIn:
+		return slices.Contains(values, value), nil
+	case v1alpha1.SelectorOpNotIn:
+		return !slices.Contains(values, value), nil
+	case v1alpha1.SelectorOpPrefix:
+		return slices.ContainsFunc(values, func(prefix string) bool {
+			return strings.HasPrefix(value, prefix)
+		}), nil
+	case v1alpha1.SelectorOpRegex:
+		for _, expr := range values {
+			re, err := compileSelectorRegex(expr)
+			if err != nil {
+				return false, fmt.Errorf("cannot evaluate selector: invalid regular expression %q: %w", expr, err)
+			}
+			if re.MatchString(value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("cannot evaluate selector: unsupported operator %q", operator)
+	}
+}
+
+// compileSelectorRegex compiles a regular expression of a selector, which must match the complete value.
+func compileSelectorRegex(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
 }
 
 func evaluateOr(selectors []v1alpha1.Selector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
@@ -98,6 +168,12 @@ func validateSelector(selector *v1alpha1.Selector) error {
 	if selector.MatchLabel != nil {
 		count++
 	}
+	if selector.MatchPurpose != nil {
+		count++
+	}
+	if len(selector.MatchExpression) > 0 {
+		count++
+	}
 	if selector.Or != nil {
 		count++
 	}
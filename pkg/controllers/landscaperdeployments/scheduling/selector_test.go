@@ -136,4 +136,118 @@ var _ = Describe("Evaluation of selectors", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(match).To(BeTrue())
 	})
+
+	It("should evaluate a tenant selector with operators", func() {
+		deployment := newLandscaperDeployment("test-tenant-1", nil)
+
+		for _, tc := range []struct {
+			selector lssv1alpha1.TenantSelector
+			match    bool
+		}{
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpIn, Values: []string{"test-tenant-0", "test-tenant-1"}}, true},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpIn, Values: []string{"test-tenant-2"}}, false},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpNotIn, Values: []string{"test-tenant-2"}}, true},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpNotIn, Values: []string{"test-tenant-1"}}, false},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"other-", "test-"}}, true},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"tenant"}}, false},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpRegex, Values: []string{"test-tenant-[0-9]+"}}, true},
+			{lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpRegex, Values: []string{"tenant-[0-9]"}}, false},
+		} {
+			match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchTenant: &tc.selector}, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(tc.match), "%s %v", tc.selector.Operator, tc.selector.Values)
+		}
+	})
+
+	It("should evaluate a label selector with operators", func() {
+		const labelName = "tier"
+
+		withLabel := newLandscaperDeployment("test-tenant", map[string]string{labelName: "gold"})
+		withoutLabel := newLandscaperDeployment("test-tenant", nil)
+
+		for _, tc := range []struct {
+			selector     lssv1alpha1.LabelSelector
+			withLabel    bool
+			withoutLabel bool
+		}{
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpExists}, true, false},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpDoesNotExist}, false, true},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpIn, Values: []string{"gold", "silver"}}, true, false},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpNotIn, Values: []string{"gold"}}, false, true},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpNotIn, Values: []string{"silver"}}, true, true},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"go"}}, true, false},
+			{lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.SelectorOpRegex, Values: []string{"g.*d"}}, true, false},
+		} {
+			match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchLabel: &tc.selector}, withLabel)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(tc.withLabel), "%s %v", tc.selector.Operator, tc.selector.Values)
+
+			match, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchLabel: &tc.selector}, withoutLabel)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(tc.withoutLabel), "%s %v", tc.selector.Operator, tc.selector.Values)
+		}
+	})
+
+	It("should evaluate a purpose selector", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+		deployment.Spec.Purpose = "production"
+
+		match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchPurpose: &lssv1alpha1.PurposeSelector{Value: "production"},
+		}, deployment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeTrue())
+
+		match, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchPurpose: &lssv1alpha1.PurposeSelector{Operator: lssv1alpha1.SelectorOpIn, Values: []string{"development", "test"}},
+		}, deployment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeFalse())
+	})
+
+	It("should evaluate an expression selector", func() {
+		deployment := newLandscaperDeployment("test-tenant", map[string]string{"region": "eu-west"})
+		deployment.Spec.Purpose = "prod"
+
+		for expression, expected := range map[string]bool{
+			`object.spec.purpose == "prod" && object.metadata.labels["region"].startsWith("eu")`: true,
+			`object.spec.purpose == "prod" && object.metadata.labels["region"].startsWith("us")`: false,
+			`object.spec.tenantId in ["test-tenant", "other-tenant"]`:                            true,
+			`has(object.metadata.labels.tier) && object.metadata.labels.tier == "gold"`:          false,
+			`object.metadata.labels["tier"] == "gold"`:                                           false,
+		} {
+			match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchExpression: expression}, deployment)
+			Expect(err).NotTo(HaveOccurred(), expression)
+			Expect(match).To(Equal(expected), expression)
+		}
+	})
+
+	It("should fail to evaluate an invalid expression selector", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+
+		_, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchExpression: `object.spec.purpose`}, deployment)
+		Expect(err).To(HaveOccurred())
+
+		_, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchExpression: `object.spec.purpose ==`}, deployment)
+		Expect(err).To(HaveOccurred())
+
+		_, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchExpression: `object.spec.purpsoe == "prod"`}, deployment)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to evaluate an expression selector whose evaluation fails", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+
+		_, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchExpression: `size(object.spec.tenantId) / 0 == 1`}, deployment)
+		Expect(err).To(MatchError(ContainSubstring("division by zero")))
+	})
+
+	It("should fail to evaluate a selector with an unsupported operator", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+
+		_, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchTenant: &lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpExists},
+		}, deployment)
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -7,35 +7,120 @@ package scheduling
 import (
 	"fmt"
 	"sort"
+	"strconv"
+
+	"k8s.io/utils/ptr"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 )
 
-// PickServiceTargetConfig selects one of the ServiceTargetConfigs, considering their priority and usage.
-// For each ServiceTargetConfig, its priority is divided by the number of already deployed LandscaperDeployments + 1.
+// PickServiceTargetConfig selects one of the ServiceTargetConfigs, considering their priority, usage and capacity.
+// ServiceTargetConfigs which have not enough capacity left for the LandscaperDeployment are excluded.
+// For each remaining ServiceTargetConfig, its priority is divided by the number of already deployed LandscaperDeployments + 1.
 // The ServiceTargetConfigs are sorted descending by these numbers.
 // The ServiceTargetConfig with the highest number is returned, i.e. the first in the sorted list.
-func PickServiceTargetConfig(configs []*lssv1alpha1.ServiceTargetConfig) (*lssv1alpha1.ServiceTargetConfig, error) {
+func PickServiceTargetConfig(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+) (*lssv1alpha1.ServiceTargetConfig, error) {
+	return pickServiceTargetConfig(configs, deployment, instances, nil, &lssv1alpha1.SchedulingExplanation{})
+}
+
+// pickServiceTargetConfig implements PickServiceTargetConfig and additionally applies the spread policy, if any.
+// With a hard spread policy, the ServiceTargetConfigs which violate it are excluded.
+// With a soft spread policy, the number of instances with the same tenant id or label value + 1 is an additional divisor.
+// The weights of the preferred topology requirements fulfilled by a ServiceTargetConfig increase its score by this percentage.
+// The excluded ServiceTargetConfigs and the ranked candidates are recorded in the explanation.
+func pickServiceTargetConfig(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+	spread *spreading,
+	explanation *lssv1alpha1.SchedulingExplanation,
+) (*lssv1alpha1.ServiceTargetConfig, error) {
 	if len(configs) == 0 {
 		err := fmt.Errorf("no service target available")
 		return nil, err
 	}
 
-	SortServiceTargetConfigs(configs)
-	return configs[0], nil
+	available, err := filterByCapacity(configs, deployment, instances, explanation)
+	if err != nil {
+		return nil, err
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("%w: all %d service target configs are full", ErrNoCapacity, len(configs))
+	}
+
+	available = filterBySpread(available, spread, explanation)
+
+	scoreOf := func(config *lssv1alpha1.ServiceTargetConfig) score {
+		d := int64(len(config.Status.InstanceRefs) + 1)
+		if spread != nil && !spread.isHard() {
+			d *= int64(spread.count(config) + 1)
+		}
+		// the affinity weight increases the score by this percentage
+		return score{
+			numerator:   config.Spec.Priority * (100 + getAffinityWeight(config, deployment)),
+			denominator: d * 100,
+		}
+	}
+	sortServiceTargetConfigs(available, scoreOf)
+
+	for _, config := range available {
+		candidate := lssv1alpha1.SchedulingCandidate{
+			ObjectReference: lssv1alpha1.ObjectReference{
+				Name:      config.Name,
+				Namespace: config.Namespace,
+			},
+			Priority:       config.Spec.Priority,
+			InstanceCount:  len(config.Status.InstanceRefs),
+			AffinityWeight: getAffinityWeight(config, deployment),
+			Score:          scoreOf(config).String(),
+		}
+		if spread != nil {
+			candidate.SpreadCount = ptr.To(spread.count(config))
+		}
+		explanation.Candidates = append(explanation.Candidates, candidate)
+	}
+
+	return available[0], nil
 }
 
 // SortServiceTargetConfigs sorts the ServiceTargetConfigs by priority and usage.
 func SortServiceTargetConfigs(configs []*lssv1alpha1.ServiceTargetConfig) {
+	sortServiceTargetConfigs(configs, func(config *lssv1alpha1.ServiceTargetConfig) score {
+		return score{
+			numerator:   config.Spec.Priority,
+			denominator: int64(len(config.Status.InstanceRefs) + 1),
+		}
+	})
+}
+
+// score is the fraction by which the ServiceTargetConfigs are ranked.
+// It is kept as fraction, so that scores can be compared without rounding errors.
+type score struct {
+	numerator   int64
+	denominator int64
+}
+
+// greater returns true if the score is greater than the other score. Both denominators are positive.
+func (s score) greater(other score) bool {
+	return s.numerator*other.denominator > other.numerator*s.denominator
+}
+
+func (s score) String() string {
+	return strconv.FormatFloat(float64(s.numerator)/float64(s.denominator), 'f', 2, 64)
+}
+
+// sortServiceTargetConfigs sorts the ServiceTargetConfigs descending by their score.
+func sortServiceTargetConfigs(configs []*lssv1alpha1.ServiceTargetConfig, scoreOf func(*lssv1alpha1.ServiceTargetConfig) score) {
 	if len(configs) == 0 {
 		return
 	}
 
 	// sort the configurations by priority and capacity
 	sort.SliceStable(configs, func(i, j int) bool {
-		l := configs[i]
-		r := configs[j]
-
-		return l.Spec.Priority*int64(len(r.Status.InstanceRefs)+1) > r.Spec.Priority*int64(len(l.Status.InstanceRefs)+1)
+		return scoreOf(configs[i]).greater(scoreOf(configs[j]))
 	})
 }
@@ -5,9 +5,12 @@
 package scheduling_test
 
 import (
+	"errors"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
@@ -173,14 +176,100 @@ var _ = Describe("PickServiceTargetConfig", func() {
 			},
 		}
 
-		conf, err := lssscheduling.PickServiceTargetConfig(configs)
+		conf, err := lssscheduling.PickServiceTargetConfig(configs, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(conf.Spec.Priority).To(Equal(int64(30)))
 	})
 
 	It("should fail if no configs are available", func() {
 		configs := make([]*lssv1alpha1.ServiceTargetConfig, 0)
-		_, err := lssscheduling.PickServiceTargetConfig(configs)
+		_, err := lssscheduling.PickServiceTargetConfig(configs, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should exclude configs which reached the maximum number of instances", func() {
+		configs := []*lssv1alpha1.ServiceTargetConfig{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "full"},
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{
+					Priority:     30,
+					MaxInstances: ptr.To[int64](1),
+				},
+				Status: lssv1alpha1.ServiceTargetConfigStatus{
+					InstanceRefs: []lssv1alpha1.ObjectReference{{Name: "foo", Namespace: "bar"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "free"},
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{
+					Priority:     10,
+					MaxInstances: ptr.To[int64](2),
+				},
+				Status: lssv1alpha1.ServiceTargetConfigStatus{
+					InstanceRefs: []lssv1alpha1.ObjectReference{{Name: "baz", Namespace: "bar"}},
+				},
+			},
+		}
+
+		conf, err := lssscheduling.PickServiceTargetConfig(configs, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.GetName()).To(Equal("free"))
+	})
+
+	It("should exclude configs whose resource budget is exhausted", func() {
+		configs := []*lssv1alpha1.ServiceTargetConfig{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "full", Namespace: "ns"},
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{
+					Priority:       30,
+					ResourceBudget: &lssv1alpha1.ResourceRequests{CPU: "2", Memory: "8Gi"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "free", Namespace: "ns"},
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{
+					Priority:       10,
+					ResourceBudget: &lssv1alpha1.ResourceRequests{CPU: "2", Memory: "8Gi"},
+				},
+			},
+		}
+
+		instances := []lssv1alpha1.Instance{
+			{
+				Spec: lssv1alpha1.InstanceSpec{
+					ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: "full", Namespace: "ns"},
+					LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+						Resources: &lssv1alpha1.Resources{Requests: lssv1alpha1.ResourceRequests{CPU: "1500m", Memory: "1Gi"}},
+					},
+				},
+			},
+		}
+
+		deployment := &lssv1alpha1.LandscaperDeployment{
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{
+				LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+					Resources: &lssv1alpha1.Resources{Requests: lssv1alpha1.ResourceRequests{CPU: "1", Memory: "1Gi"}},
+				},
+			},
+		}
+
+		conf, err := lssscheduling.PickServiceTargetConfig(configs, deployment, instances)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.GetName()).To(Equal("free"))
+	})
+
+	It("should fail with no capacity if all configs are full", func() {
+		configs := []*lssv1alpha1.ServiceTargetConfig{
+			{
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{
+					Priority:     10,
+					MaxInstances: ptr.To[int64](0),
+				},
+			},
+		}
+
+		_, err := lssscheduling.PickServiceTargetConfig(configs, nil, nil)
 		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, lssscheduling.ErrNoCapacity)).To(BeTrue())
 	})
 })
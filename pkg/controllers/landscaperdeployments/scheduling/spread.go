@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// spreading is a spread policy together with the number of instances per ServiceTargetConfig
+// which have the same tenant id or label value as the LandscaperDeployment.
+type spreading struct {
+	policy *lssv1alpha1.SpreadPolicy
+	counts map[lssv1alpha1.ObjectReference]int
+}
+
+// getSpreadPolicy returns the spread policy of the first matched rule which specifies one.
+func getSpreadPolicy(scheduling *lssv1alpha1.TargetScheduling, matchedRules []lssv1alpha1.MatchedSchedulingRule) *lssv1alpha1.SpreadPolicy {
+	for _, matchedRule := range matchedRules {
+		if spread := scheduling.Spec.Rules[matchedRule.Index].Spread; spread != nil {
+			return spread
+		}
+	}
+	return nil
+}
+
+// newSpreading counts the instances with the same tenant id or label value as the LandscaperDeployment per ServiceTargetConfig.
+// The LandscaperDeployments are needed to determine the label values of the instances.
+// Nil is returned if there is no spread policy, or the LandscaperDeployment doesn't have the label of the spread policy.
+func newSpreading(
+	policy *lssv1alpha1.SpreadPolicy,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+	deployments []lssv1alpha1.LandscaperDeployment,
+) *spreading {
+
+	if policy == nil {
+		return nil
+	}
+
+	// the owning landscaper deployment is only needed to spread by label
+	var owners map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment
+	if len(policy.LabelName) > 0 {
+		if _, ok := deployment.Labels[policy.LabelName]; !ok {
+			return nil
+		}
+
+		owners = make(map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment, len(deployments))
+		for i := range deployments {
+			owners[types.NamespacedName{Name: deployments[i].Name, Namespace: deployments[i].Namespace}] = &deployments[i]
+		}
+	}
+
+	result := &spreading{
+		policy: policy,
+		counts: map[lssv1alpha1.ObjectReference]int{},
+	}
+
+	for i := range instances {
+		instance := &instances[i]
+
+		if len(policy.LabelName) > 0 {
+			owner := GetOwningDeployment(instance, owners)
+			if owner == nil || owner.Labels[policy.LabelName] != deployment.Labels[policy.LabelName] {
+				continue
+			}
+		} else if instance.Spec.TenantId != deployment.Spec.TenantId {
+			continue
+		}
+
+		result.counts[instance.Spec.ServiceTargetConfigRef]++
+	}
+
+	return result
+}
+
+// GetOwningDeployment returns the LandscaperDeployment which controls the instance, or nil if there is none.
+// The owners are the LandscaperDeployments by their namespaced name.
+func GetOwningDeployment(instance *lssv1alpha1.Instance, owners map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment) *lssv1alpha1.LandscaperDeployment {
+	ownerRef := metav1.GetControllerOf(instance)
+	if ownerRef == nil || ownerRef.Kind != "LandscaperDeployment" {
+		return nil
+	}
+	return owners[types.NamespacedName{Name: ownerRef.Name, Namespace: instance.Namespace}]
+}
+
+// count returns the number of instances on the ServiceTargetConfig with the same tenant id or label value.
+func (s *spreading) count(config *lssv1alpha1.ServiceTargetConfig) int {
+	return s.counts[lssv1alpha1.ObjectReference{Name: config.Name, Namespace: config.Namespace}]
+}
+
+func (s *spreading) isHard() bool {
+	return s.policy.Mode == lssv1alpha1.SpreadModeHard
+}
+
+func (s *spreading) maxSkew() int {
+	if s.policy.MaxSkew == nil {
+		return 1
+	}
+	return int(*s.policy.MaxSkew)
+}
+
+// subject describes by which attribute the instances are spread.
+func (s *spreading) subject() string {
+	if len(s.policy.LabelName) > 0 {
+		return fmt.Sprintf("label %s", s.policy.LabelName)
+	}
+	return "tenant id"
+}
+
+// filterBySpread excludes the ServiceTargetConfigs which violate a hard spread policy.
+// These are the ServiceTargetConfigs on which the number of instances with the same tenant id or label value
+// exceeds the minimum number of all ServiceTargetConfigs by the maximum skew or more.
+// The ServiceTargetConfig with the minimum number always remains, so that the result is never empty.
+func filterBySpread(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	spread *spreading,
+	explanation *lssv1alpha1.SchedulingExplanation,
+) []*lssv1alpha1.ServiceTargetConfig {
+
+	if spread == nil || !spread.isHard() || len(configs) == 0 {
+		return configs
+	}
+
+	minCount := spread.count(configs[0])
+	for _, config := range configs[1:] {
+		minCount = min(minCount, spread.count(config))
+	}
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	for _, config := range configs {
+		if count := spread.count(config); count-minCount >= spread.maxSkew() {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+				Reason: lssv1alpha1.SchedulingReasonSpreadConstraint,
+				Message: fmt.Sprintf("hosts %d instances with the same %s, the minimum is %d and the maximum skew %d",
+					count, spread.subject(), minCount, spread.maxSkew()),
+			})
+			continue
+		}
+		result = append(result, config)
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// getTopologyValue returns the attribute of the topology of the ServiceTargetConfig with the given key.
+// An empty string is returned if the ServiceTargetConfig doesn't specify the attribute.
+func getTopologyValue(config *lssv1alpha1.ServiceTargetConfig, key lssv1alpha1.TopologyKey) string {
+	topology := config.Spec.Topology
+	if topology == nil {
+		return ""
+	}
+
+	switch key {
+	case lssv1alpha1.TopologyKeyRegion:
+		return topology.Region
+	case lssv1alpha1.TopologyKeyProvider:
+		return topology.Provider
+	case lssv1alpha1.TopologyKeyZone:
+		return topology.Zone
+	default:
+		return ""
+	}
+}
+
+// evaluateTopologyRequirement checks whether the topology of the ServiceTargetConfig fulfills the requirement.
+func evaluateTopologyRequirement(requirement *lssv1alpha1.TopologyRequirement, config *lssv1alpha1.ServiceTargetConfig) (bool, error) {
+	value := getTopologyValue(config, requirement.Key)
+
+	switch requirement.Operator {
+	case lssv1alpha1.SelectorOpIn, lssv1alpha1.SelectorOpPrefix:
+		if len(value) == 0 {
+			return false, nil
+		}
+	case lssv1alpha1.SelectorOpNotIn:
+		if len(value) == 0 {
+			return true, nil
+		}
+	default:
+		return false, fmt.Errorf("cannot evaluate topology requirement: unsupported operator %q", requirement.Operator)
+	}
+
+	return evaluateValue(requirement.Operator, "", requirement.Values, value)
+}
+
+// filterByTopology excludes the ServiceTargetConfigs which don't fulfill the required topology affinity of the LandscaperDeployment.
+func filterByTopology(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	explanation *lssv1alpha1.SchedulingExplanation,
+) ([]*lssv1alpha1.ServiceTargetConfig, error) {
+
+	affinity := deployment.Spec.TopologyAffinity
+	if affinity == nil || len(affinity.Required) == 0 {
+		return configs, nil
+	}
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	for _, config := range configs {
+		var violated []string
+		for i := range affinity.Required {
+			requirement := &affinity.Required[i]
+			match, err := evaluateTopologyRequirement(requirement, config)
+			if err != nil {
+				return nil, err
+			}
+			if !match {
+				violated = append(violated, fmt.Sprintf("%s %s %v", requirement.Key, requirement.Operator, requirement.Values))
+			}
+		}
+
+		if len(violated) > 0 {
+			explanation.FilteredOut = append(explanation.FilteredOut, lssv1alpha1.FilteredServiceTargetConfig{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+				Reason:  lssv1alpha1.SchedulingReasonTopologyAffinity,
+				Message: fmt.Sprintf("topology doesn't fulfill the required affinity: %s", strings.Join(violated, ", ")),
+			})
+			continue
+		}
+		result = append(result, config)
+	}
+	return result, nil
+}
+
+// getAffinityWeight sums up the weights of the preferred topology requirements of the LandscaperDeployment
+// which are fulfilled by the ServiceTargetConfig. Invalid requirements are ignored.
+func getAffinityWeight(config *lssv1alpha1.ServiceTargetConfig, deployment *lssv1alpha1.LandscaperDeployment) int64 {
+	if deployment == nil || deployment.Spec.TopologyAffinity == nil {
+		return 0
+	}
+	affinity := deployment.Spec.TopologyAffinity
+
+	var weight int64
+	for i := range affinity.Preferred {
+		preferred := &affinity.Preferred[i]
+		if match, err := evaluateTopologyRequirement(&preferred.TopologyRequirement, config); err == nil && match {
+			weight += preferred.Weight
+		}
+	}
+	return weight
+}
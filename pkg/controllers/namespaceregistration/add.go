@@ -5,6 +5,8 @@
 package namespaceregistration
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -16,16 +18,21 @@ import (
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 )
 
 // AddControllerToManager adds the Namespaceregistration Controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.TargetShootSidecarConfiguration) error {
 	log := logger.Reconciles("NamespaceRegistrationController", "NamespaceRegistration")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("namespace-registration-controller"), config)
 	if err != nil {
 		return err
 	}
 
+	if err := metrics.RegisterNamespaceRegistrationCollector(mgr.GetClient()); err != nil {
+		return fmt.Errorf("unable to register namespace registration metrics: %w", err)
+	}
+
 	predicates := builder.WithPredicates(predicate.Or(
 		predicate.LabelChangedPredicate{},
 		predicate.GenerationChangedPredicate{},
@@ -18,12 +18,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
 	"github.com/gardener/landscaper-service/pkg/controllers/subjectsync"
 	"github.com/gardener/landscaper-service/pkg/operation"
 	"github.com/gardener/landscaper-service/pkg/utils"
@@ -48,13 +50,13 @@ type Controller struct {
 	HandleDeleteFunc func(ctx context.Context, namespaceRegistration *lssv1alpha1.NamespaceRegistration) (reconcile.Result, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 	ctrl.ReconcileFunc = ctrl.reconcile
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
-	op := operation.NewTargetShootSidecarOperation(c, scheme, config)
+	op := operation.NewTargetShootSidecarOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.TargetShootSidecarOperation = *op
 	return ctrl, nil
 }
@@ -92,6 +94,7 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			err := fmt.Errorf("name must start with %q", subjectsync.CUSTOM_NS_PREFIX)
 			lastError := c.createError(namespaceRegistration.Status.Phase, ReasonInvalidName, err)
 			c.updateStatus(namespaceRegistration, PhaseFailed, lastError)
+			c.EventRecorder().Event(namespaceRegistration, corev1.EventTypeWarning, operation.EventReasonNamespaceRegistrationFailed, err.Error())
 			if err := c.Client().Status().Update(ctx, namespaceRegistration); err != nil {
 				logger.Error(err, "failed updating namespaceregistration with invalid name - must start with "+subjectsync.CUSTOM_NS_PREFIX)
 				return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
@@ -151,6 +154,8 @@ func (c *Controller) removeResourcesAndNamespace(ctx context.Context, namespaceR
 			logger.Error(err, "failed updating status of namespaceregistration when starting deletion")
 			return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 		}
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, operation.EventReasonDeleting,
+			"Deleting the resources in namespace %s", namespace.Name)
 	}
 
 	// check if installations, executions, deploy items or target sync objects are still there
@@ -230,6 +235,7 @@ func (c *Controller) removeAccessDataAndNamespace(ctx context.Context, namespace
 	if err := c.Client().Delete(ctx, namespace); err != nil {
 		return c.logErrorUpdateAndRetry(ctx, namespaceRegistration, PhaseDeleting, "failed deleting namespace", err)
 	}
+	c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, operation.EventReasonDeleting, "Deleting namespace %s", namespace.Name)
 
 	controllerutil.RemoveFinalizer(namespaceRegistration, lssv1alpha1.LandscaperServiceFinalizer)
 	if err := c.Client().Update(ctx, namespaceRegistration); err != nil {
@@ -302,6 +308,7 @@ func (c *Controller) reconcile(ctx context.Context, namespaceRegistration *lssv1
 		logger.Error(err, "failed updating status of namespaceregistration after completion")
 		return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 	}
+	c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, operation.EventReasonNamespaceRegistered, "Registered namespace %s", namespace.Name)
 	return reconcile.Result{}, nil
 }
 
@@ -331,6 +338,12 @@ func (c *Controller) logErrorUpdateAndRetry(ctx context.Context, namespaceRegist
 
 	if err != nil {
 		logger.Error(err, msg)
+
+		reason := operation.EventReasonNamespaceRegistrationFailed
+		if phase == PhaseDeleting {
+			reason = operation.EventReasonDeletionFailed
+		}
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeWarning, reason, "%s: %s", msg, err.Error())
 	} else {
 		logger.Info(msg)
 	}
@@ -348,6 +361,18 @@ func (c *Controller) updateStatus(namespaceRegistration *lssv1alpha1.NamespaceRe
 	lastError *lssv1alpha1.Error) {
 	namespaceRegistration.Status.Phase = phase
 	namespaceRegistration.Status.LastError = lastError
+
+	conditions := &namespaceRegistration.Status.Conditions
+	generation := namespaceRegistration.GetGeneration()
+	switch {
+	case phase == PhaseCompleted:
+		lsserrors.SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionTrue, PhaseCompleted, "", generation)
+	case lastError != nil:
+		lsserrors.SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionFalse, lastError.Reason, lastError.Message, generation)
+	default:
+		lsserrors.SetCondition(conditions, lssv1alpha1.ConditionTypeReady, metav1.ConditionFalse, phase,
+			fmt.Sprintf("namespace registration is in phase %s", phase), generation)
+	}
 }
 
 func (c *Controller) createError(phase, reason string, err error) *lssv1alpha1.Error {
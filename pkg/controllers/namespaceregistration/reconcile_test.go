@@ -6,6 +6,7 @@ package namespaceregistration_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -16,8 +17,10 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -65,6 +68,7 @@ var _ = Describe("Reconcile", func() {
 		Expect(len(namespaceRegistration.Finalizers)).To(Equal(1))
 		Expect(namespaceRegistration.Finalizers[0]).To(Equal(lssv1alpha1.LandscaperServiceFinalizer))
 		Expect(namespaceRegistration.Status.Phase).To(Equal("Completed"))
+		Expect(meta.IsStatusConditionTrue(namespaceRegistration.Status.Conditions, lssv1alpha1.ConditionTypeReady)).To(BeTrue())
 
 		// check for namespace being created
 		namespace := corev1.Namespace{}
@@ -100,6 +104,33 @@ var _ = Describe("Reconcile", func() {
 		Expect(namespace.Status.Phase).To(Equal(corev1.NamespaceTerminating))
 	})
 
+	It("should record events for the registration and the deletion of the namespace", func() {
+		var err error
+
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(10)
+		ctrl = namespaceregistration.NewTestActuator(*op.WithEventRecorder(recorder), logging.Discard())
+
+		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-2")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal %s Registered namespace %s",
+			operation.EventReasonNamespaceRegistered, namespaceRegistration.Name))))
+
+		// a completed registration is not recorded again
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
+		Expect(recorder.Events).ToNot(Receive())
+
+		Expect(testenv.Client.Delete(ctx, namespaceRegistration)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, namespaceRegistration, 5*time.Second)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal %s Deleting the resources in namespace %s",
+			operation.EventReasonDeleting, namespaceRegistration.Name))))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal %s Deleting namespace %s",
+			operation.EventReasonDeleting, namespaceRegistration.Name))))
+	})
+
 	It("should delete namespace with installation", func() {
 		var err error
 
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// AddControllerToManager adds the rebalancing controller to the manager.
+// The controller is only added if the rebalancing is configured.
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
+	if config.Rebalancing == nil {
+		return nil
+	}
+
+	log := logger.Reconciles("rebalancing", "RebalancePlan")
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("rebalancing-controller"), config)
+	if err != nil {
+		return err
+	}
+
+	planRequest := reconcile.Request{
+		NamespacedName: client.ObjectKey{Name: config.Rebalancing.PlanName, Namespace: config.Rebalancing.PlanNamespace},
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("rebalancing-controller").
+		For(&v1alpha1.RebalancePlan{}).
+		// the service target configs trigger the creation of the rebalance plan when the controller is started
+		Watches(&v1alpha1.ServiceTargetConfig{},
+			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, _ client.Object) []reconcile.Request {
+				return []reconcile.Request{planRequest}
+			}),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
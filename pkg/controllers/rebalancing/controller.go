@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/operation"
+)
+
+// Controller is the rebalancing controller
+type Controller struct {
+	operation.Operation
+	log logging.Logger
+}
+
+// NewController returns a new rebalancing controller
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	ctrl := &Controller{
+		log: logger,
+	}
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
+	ctrl.Operation = *op
+	return ctrl, nil
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	ctrl := &Controller{
+		Operation: op,
+		log:       logger,
+	}
+	return ctrl
+}
+
+// Reconcile reconciles requests for the rebalance plan.
+// An approved plan is carried out, otherwise new moves are proposed once the rebalancing interval has passed.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	rebalancing := c.Config().Rebalancing
+	if rebalancing == nil || req.Name != rebalancing.PlanName || req.Namespace != rebalancing.PlanNamespace {
+		return reconcile.Result{}, nil
+	}
+
+	plan := &lssv1alpha1.RebalancePlan{}
+	if err := c.Client().Get(ctx, req.NamespacedName, plan); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		logger.Info("creating rebalance plan")
+		plan = &lssv1alpha1.RebalancePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      req.Name,
+				Namespace: req.Namespace,
+			},
+		}
+		if err := c.Client().Create(ctx, plan); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to create rebalance plan: %w", err)
+		}
+	}
+
+	if isExecutable(plan) {
+		return c.execute(ctx, plan)
+	}
+
+	interval := rebalancing.Interval.Duration
+	if plan.Status.LastProposalTime != nil {
+		nextProposal := plan.Status.LastProposalTime.Add(interval)
+		if time.Now().Before(nextProposal) {
+			return reconcile.Result{RequeueAfter: time.Until(nextProposal)}, nil
+		}
+	}
+
+	if err := c.propose(ctx, plan); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("proposed rebalance plan", "moves", len(plan.Spec.Moves), lc.KeyResource, client.ObjectKeyFromObject(plan).String())
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// isExecutable returns true if the plan has been approved and not all of its moves are finished.
+func isExecutable(plan *lssv1alpha1.RebalancePlan) bool {
+	if !plan.Spec.Approved || len(plan.Spec.Moves) == 0 {
+		return false
+	}
+	return plan.Status.ObservedGeneration != plan.Generation || plan.Status.Phase != lssv1alpha1.RebalancePlanPhaseCompleted
+}
+
+// propose compares the distribution of the instances with the scheduling and writes the proposed moves into the plan.
+// The approval is reset if the moves have changed or the plan has already been carried out.
+func (c *Controller) propose(ctx context.Context, plan *lssv1alpha1.RebalancePlan) error {
+	rebalancing := c.Config().Rebalancing
+
+	serviceTargetConfigs, err := lssscheduling.GetVisibleServiceTargetConfigs(ctx, c.Client())
+	if err != nil {
+		return err
+	}
+
+	var schedulingKey *client.ObjectKey
+	if scheduling := c.Config().Scheduling; scheduling != nil {
+		schedulingKey = &client.ObjectKey{Name: scheduling.Name, Namespace: scheduling.Namespace}
+	}
+	scheduling, err := lssscheduling.GetTargetScheduling(ctx, c.Client(), schedulingKey)
+	if err != nil {
+		return err
+	}
+
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.Client().List(ctx, instanceList); err != nil {
+		return fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := c.Client().List(ctx, deploymentList); err != nil {
+		return fmt.Errorf("unable to list landscaper deployments: %w", err)
+	}
+
+	moves := ProposeMoves(scheduling, serviceTargetConfigs, instanceList.Items, deploymentList.Items,
+		rebalancing.MinScoreImprovement, rebalancing.MaxMovesPerPlan)
+
+	if plan.Spec.Approved || !equality.Semantic.DeepEqual(moves, plan.Spec.Moves) {
+		plan.Spec.Approved = false
+		plan.Spec.Moves = moves
+		if len(moves) == 0 {
+			plan.Spec.Moves = nil
+		}
+		if err := c.Client().Update(ctx, plan); err != nil {
+			return fmt.Errorf("unable to update rebalance plan: %w", err)
+		}
+		if len(moves) > 0 {
+			c.EventRecorder().Eventf(plan, corev1.EventTypeNormal, operation.EventReasonRebalanceProposed,
+				"Proposed %d moves, the moves are carried out after the plan has been approved", len(moves))
+		}
+	}
+
+	plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseProposed
+	if len(plan.Spec.Moves) == 0 {
+		plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseBalanced
+	}
+	plan.Status.LastProposalTime = &metav1.Time{Time: time.Now()}
+	plan.Status.Moves = nil
+	if err := c.Client().Status().Update(ctx, plan); err != nil {
+		return fmt.Errorf("unable to update rebalance plan status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// moveRetryDuration is the duration after which the moves of an approved plan are checked again.
+	moveRetryDuration = time.Second * 30
+)
+
+// execute carries out the moves of an approved plan.
+// The migration of an instance is requested with the migration annotation, which is handled by the instance controller.
+// A migration is only requested while the maintenance time window of the instance is open,
+// at most the configured number of instances are migrated at the same time,
+// and the configured migration interval has to pass between the start of two migrations.
+func (c *Controller) execute(ctx context.Context, plan *lssv1alpha1.RebalancePlan) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(plan).String()},
+		lc.KeyMethod, "execute")
+
+	oldStatus := plan.Status.DeepCopy()
+
+	// the plan has been approved, or the moves have been changed after the approval
+	if plan.Status.ObservedGeneration != plan.Generation || len(plan.Status.Moves) != len(plan.Spec.Moves) {
+		plan.Status.ObservedGeneration = plan.Generation
+		plan.Status.Moves = make([]lssv1alpha1.RebalanceMoveStatus, 0, len(plan.Spec.Moves))
+		for _, move := range plan.Spec.Moves {
+			plan.Status.Moves = append(plan.Status.Moves, lssv1alpha1.RebalanceMoveStatus{
+				InstanceRef: move.InstanceRef,
+				Phase:       lssv1alpha1.RebalanceMovePhasePending,
+			})
+		}
+	}
+	plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseProgressing
+
+	migrating := 0
+	for i := range plan.Spec.Moves {
+		moveStatus := &plan.Status.Moves[i]
+		if moveStatus.Phase == lssv1alpha1.RebalanceMovePhaseMigrating {
+			if err := c.checkMove(ctx, &plan.Spec.Moves[i], moveStatus); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		if moveStatus.Phase == lssv1alpha1.RebalanceMovePhaseMigrating {
+			migrating++
+		}
+	}
+
+	now := time.Now()
+	for i := range plan.Spec.Moves {
+		moveStatus := &plan.Status.Moves[i]
+		if moveStatus.Phase != lssv1alpha1.RebalanceMovePhasePending {
+			continue
+		}
+
+		if migrating >= c.Config().Rebalancing.MaxConcurrentMigrations {
+			moveStatus.Message = "waiting for running migrations to finish"
+			continue
+		}
+
+		if last := plan.Status.LastMigrationTime; last != nil && now.Before(last.Add(c.Config().Rebalancing.MigrationInterval.Duration)) {
+			moveStatus.Message = fmt.Sprintf("waiting for the migration interval, the next migration can be started at %s",
+				last.Add(c.Config().Rebalancing.MigrationInterval.Duration).Format(time.RFC3339))
+			continue
+		}
+
+		started, err := c.startMove(ctx, &plan.Spec.Moves[i], moveStatus, now)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if started {
+			logger.Info("Requested migration of instance", "instance", moveStatus.InstanceRef.NamespacedName().String(),
+				"target", plan.Spec.Moves[i].TargetServiceTargetConfigRef.NamespacedName().String())
+			c.EventRecorder().Eventf(plan, corev1.EventTypeNormal, operation.EventReasonMigrationRequested,
+				"Requested migration of instance %s to service target config %s", moveStatus.InstanceRef.NamespacedName().String(),
+				plan.Spec.Moves[i].TargetServiceTargetConfigRef.NamespacedName().String())
+			plan.Status.LastMigrationTime = &metav1.Time{Time: now}
+			migrating++
+		}
+	}
+
+	completed := true
+	for _, moveStatus := range plan.Status.Moves {
+		if !moveStatus.Phase.IsFinished() {
+			completed = false
+			break
+		}
+	}
+	if completed {
+		plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseCompleted
+	}
+
+	if !equality.Semantic.DeepEqual(oldStatus, &plan.Status) {
+		if err := c.Client().Status().Update(ctx, plan); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to update rebalance plan status: %w", err)
+		}
+	}
+
+	if completed {
+		if oldStatus.Phase != lssv1alpha1.RebalancePlanPhaseCompleted {
+			c.EventRecorder().Event(plan, corev1.EventTypeNormal, operation.EventReasonRebalanceCompleted, "All moves of the rebalance plan are finished")
+		}
+		logger.Info("rebalance plan completed")
+		return reconcile.Result{RequeueAfter: c.Config().Rebalancing.Interval.Duration}, nil
+	}
+	return reconcile.Result{RequeueAfter: moveRetryDuration}, nil
+}
+
+// startMove requests the migration of the instance of a pending move, if all preconditions are fulfilled.
+// A move which is no longer applicable is skipped. Returns true if the migration has been requested.
+func (c *Controller) startMove(ctx context.Context, move *lssv1alpha1.RebalanceMove, moveStatus *lssv1alpha1.RebalanceMoveStatus, now time.Time) (bool, error) {
+	skip := func(message string) (bool, error) {
+		moveStatus.Phase = lssv1alpha1.RebalanceMovePhaseSkipped
+		moveStatus.Message = message
+		return false, nil
+	}
+
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, move.InstanceRef.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return skip("instance has been deleted")
+		}
+		return false, fmt.Errorf("unable to get instance %s: %w", move.InstanceRef.NamespacedName().String(), err)
+	}
+
+	if !instance.Spec.ServiceTargetConfigRef.Equals(&move.SourceServiceTargetConfigRef) {
+		return skip(fmt.Sprintf("instance is no longer scheduled on service target config %s", move.SourceServiceTargetConfigRef.NamespacedName().String()))
+	}
+
+	if instance.IsMigrating() {
+		moveStatus.Message = "waiting for a running migration of the instance to finish"
+		return false, nil
+	}
+
+	target := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, move.TargetServiceTargetConfigRef.NamespacedName(), target); err != nil {
+		if apierrors.IsNotFound(err) {
+			return skip(fmt.Sprintf("service target config %s has been deleted", move.TargetServiceTargetConfigRef.NamespacedName().String()))
+		}
+		return false, fmt.Errorf("unable to get service target config %s: %w", move.TargetServiceTargetConfigRef.NamespacedName().String(), err)
+	}
+
+	if target.IsCordoned() {
+		return skip(fmt.Sprintf("service target config %s is cordoned: %s", move.TargetServiceTargetConfigRef.NamespacedName().String(), target.Spec.Cordon.Reason))
+	}
+
+	if target.IsNotReady() {
+		moveStatus.Message = fmt.Sprintf("waiting for service target config %s to become ready", move.TargetServiceTargetConfigRef.NamespacedName().String())
+		return false, nil
+	}
+
+	timeWindow, err := utils.GetMaintenanceTimeWindow(instance, c.Config().ShootConfiguration.Maintenance.TimeWindow)
+	if err != nil {
+		return skip(err.Error())
+	}
+	if timeWindow != nil && !timeWindow.Contains(now) {
+		moveStatus.Message = fmt.Sprintf("waiting for the maintenance time window of the instance at %s", timeWindow.NextBegin(now).Format(time.RFC3339))
+		return false, nil
+	}
+
+	if instance.Annotations == nil {
+		instance.Annotations = map[string]string{}
+	}
+	instance.Annotations[lssv1alpha1.InstanceMigrationAnnotation] = fmt.Sprintf("%s/%s", target.Namespace, target.Name)
+	if err := c.Client().Update(ctx, instance); err != nil {
+		return false, fmt.Errorf("unable to request migration of instance %s: %w", move.InstanceRef.NamespacedName().String(), err)
+	}
+
+	moveStatus.Phase = lssv1alpha1.RebalanceMovePhaseMigrating
+	moveStatus.StartTime = &metav1.Time{Time: now}
+	moveStatus.Message = ""
+	return true, nil
+}
+
+// checkMove updates the phase of a move whose migration has been requested.
+// The move succeeded when the instance is scheduled on the target service target config and no migration is pending anymore.
+func (c *Controller) checkMove(ctx context.Context, move *lssv1alpha1.RebalanceMove, moveStatus *lssv1alpha1.RebalanceMoveStatus) error {
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, move.InstanceRef.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			moveStatus.Phase = lssv1alpha1.RebalanceMovePhaseSkipped
+			moveStatus.Message = "instance has been deleted"
+			return nil
+		}
+		return fmt.Errorf("unable to get instance %s: %w", move.InstanceRef.NamespacedName().String(), err)
+	}
+
+	if instance.IsMigrating() {
+		if migration := instance.Status.Migration; migration != nil {
+			moveStatus.Message = migration.Message
+		}
+		return nil
+	}
+
+	if instance.Spec.ServiceTargetConfigRef.Equals(&move.TargetServiceTargetConfigRef) {
+		moveStatus.Phase = lssv1alpha1.RebalanceMovePhaseSucceeded
+		moveStatus.Message = ""
+		return nil
+	}
+
+	moveStatus.Phase = lssv1alpha1.RebalanceMovePhaseFailed
+	moveStatus.Message = "the migration annotation has been removed before the instance has been migrated"
+	return nil
+}
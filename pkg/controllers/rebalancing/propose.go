@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// ProposeMoves compares the distribution of the instances with the distribution the scheduling would give.
+// Each instance is scheduled again, as if its LandscaperDeployment was created with all other instances in place.
+// A move is proposed if the scheduling selects a different ServiceTargetConfig, and either the current ServiceTargetConfig
+// is no longer a candidate, or the score of the selected ServiceTargetConfig is higher by at least minScoreImprovement percent.
+// The proposed moves are taken into account when scheduling the following instances.
+// Instances which are migrating, which are not owned by a LandscaperDeployment, or whose ServiceTargetConfig is cordoned,
+// not ready or not visible are not moved.
+// At most maxMoves moves are proposed.
+func ProposeMoves(
+	scheduling *lssv1alpha1.TargetScheduling,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance,
+	deployments []lssv1alpha1.LandscaperDeployment,
+	minScoreImprovement int64,
+	maxMoves int,
+) []lssv1alpha1.RebalanceMove {
+
+	// the instance references of the service target configs and the service target config references of the instances
+	// are modified to simulate the moves, hence both are copied
+	configs := make([]lssv1alpha1.ServiceTargetConfig, len(serviceTargetConfigs))
+	for i := range serviceTargetConfigs {
+		serviceTargetConfigs[i].DeepCopyInto(&configs[i])
+	}
+	simulated := make([]lssv1alpha1.Instance, len(instances))
+	for i := range instances {
+		instances[i].DeepCopyInto(&simulated[i])
+	}
+	sort.SliceStable(simulated, func(i, j int) bool {
+		return instanceKey(&simulated[i]) < instanceKey(&simulated[j])
+	})
+
+	owners := make(map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment, len(deployments))
+	for i := range deployments {
+		owners[types.NamespacedName{Name: deployments[i].Name, Namespace: deployments[i].Namespace}] = &deployments[i]
+	}
+
+	moves := make([]lssv1alpha1.RebalanceMove, 0)
+	for i := range simulated {
+		if len(moves) >= maxMoves {
+			break
+		}
+
+		instance := &simulated[i]
+		if !instance.DeletionTimestamp.IsZero() || instance.IsMigrating() {
+			continue
+		}
+
+		deployment := lssscheduling.GetOwningDeployment(instance, owners)
+		if deployment == nil {
+			continue
+		}
+
+		source := findServiceTargetConfig(configs, &instance.Spec.ServiceTargetConfigRef)
+		if source == nil || source.IsCordoned() || source.IsNotReady() {
+			continue
+		}
+
+		// remove the instance from its service target config, so that it is scheduled as a new instance
+		sourceRef := instance.Spec.ServiceTargetConfigRef
+		instanceRef := lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}
+		source.Status.InstanceRefs = utils.RemoveReference(source.Status.InstanceRefs, &instanceRef)
+		instance.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{}
+
+		target, explanation, err := lssscheduling.ExplainServiceTargetConfig(scheduling, deployment, configs, simulated, deployments)
+
+		reason := ""
+		if err == nil && !sourceRef.IsObject(target) {
+			reason = getMoveReason(explanation, &sourceRef, minScoreImprovement)
+		}
+
+		if len(reason) == 0 {
+			// the instance stays on its service target config
+			source.Status.InstanceRefs = append(source.Status.InstanceRefs, instanceRef)
+			instance.Spec.ServiceTargetConfigRef = sourceRef
+			continue
+		}
+
+		targetRef := lssv1alpha1.ObjectReference{Name: target.Name, Namespace: target.Namespace}
+		target = findServiceTargetConfig(configs, &targetRef)
+		target.Status.InstanceRefs = append(target.Status.InstanceRefs, instanceRef)
+		instance.Spec.ServiceTargetConfigRef = targetRef
+
+		moves = append(moves, lssv1alpha1.RebalanceMove{
+			InstanceRef:                  instanceRef,
+			SourceServiceTargetConfigRef: sourceRef,
+			TargetServiceTargetConfigRef: targetRef,
+			Reason:                       reason,
+		})
+	}
+
+	return moves
+}
+
+// getMoveReason returns why the instance should be moved away from its current service target config.
+// An empty string is returned if the instance should stay.
+func getMoveReason(explanation *lssv1alpha1.SchedulingExplanation, sourceRef *lssv1alpha1.ObjectReference, minScoreImprovement int64) string {
+	if len(explanation.Candidates) == 0 {
+		return ""
+	}
+	selected := explanation.Candidates[0]
+
+	for i := range explanation.Candidates {
+		candidate := &explanation.Candidates[i]
+		if !candidate.ObjectReference.Equals(sourceRef) {
+			continue
+		}
+
+		selectedScore, err1 := strconv.ParseFloat(selected.Score, 64)
+		sourceScore, err2 := strconv.ParseFloat(candidate.Score, 64)
+		if err1 != nil || err2 != nil {
+			return ""
+		}
+		if selectedScore <= sourceScore || selectedScore*100 < sourceScore*float64(100+minScoreImprovement) {
+			return ""
+		}
+		return fmt.Sprintf("score %s of service target config %s is higher than score %s of service target config %s",
+			selected.Score, selected.NamespacedName().String(), candidate.Score, sourceRef.NamespacedName().String())
+	}
+
+	for i := range explanation.FilteredOut {
+		filtered := &explanation.FilteredOut[i]
+		if filtered.ObjectReference.Equals(sourceRef) {
+			return fmt.Sprintf("service target config %s is filtered out by the scheduling: %s: %s",
+				sourceRef.NamespacedName().String(), filtered.Reason, filtered.Message)
+		}
+	}
+
+	return fmt.Sprintf("service target config %s is not selected by the scheduling rules", sourceRef.NamespacedName().String())
+}
+
+// findServiceTargetConfig returns the service target config with the given reference, or nil if it doesn't exist.
+func findServiceTargetConfig(configs []lssv1alpha1.ServiceTargetConfig, ref *lssv1alpha1.ObjectReference) *lssv1alpha1.ServiceTargetConfig {
+	for i := range configs {
+		if configs[i].Name == ref.Name && configs[i].Namespace == ref.Namespace {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// instanceKey returns the key by which the instances are ordered.
+func instanceKey(instance *lssv1alpha1.Instance) string {
+	return types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}.String()
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/controllers/rebalancing"
+)
+
+var _ = Describe("ProposeMoves", func() {
+
+	const (
+		namespace1 = "test-namespace-1"
+
+		config1 = "test-config-1"
+		config2 = "test-config-2"
+		config3 = "test-config-3"
+
+		tenant1 = "test-tenant-1"
+	)
+
+	var (
+		configs     []lssv1alpha1.ServiceTargetConfig
+		instances   []lssv1alpha1.Instance
+		deployments []lssv1alpha1.LandscaperDeployment
+	)
+
+	BeforeEach(func() {
+		configs = nil
+		instances = nil
+		deployments = nil
+	})
+
+	// the returned pointer is only valid until the next service target config is added
+	addServiceTargetConfig := func(name string, prio int64) *lssv1alpha1.ServiceTargetConfig {
+		configs = append(configs, lssv1alpha1.ServiceTargetConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.ServiceTargetConfigSpec{
+				Priority: prio,
+			},
+		})
+		return &configs[len(configs)-1]
+	}
+
+	// addInstance adds an instance, its owning landscaper deployment and the instance reference of the service target config.
+	addInstance := func(name, configName string) {
+		deployments = append(deployments, lssv1alpha1.LandscaperDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{
+				TenantId: tenant1,
+			},
+		})
+		instances = append(instances, lssv1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "LandscaperDeployment", Name: name, Controller: ptr.To(true)},
+				},
+			},
+			Spec: lssv1alpha1.InstanceSpec{
+				TenantId:               tenant1,
+				ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: configName, Namespace: namespace1},
+			},
+		})
+		for i := range configs {
+			if configs[i].Name == configName {
+				configs[i].Status.InstanceRefs = append(configs[i].Status.InstanceRefs, lssv1alpha1.ObjectReference{Name: name, Namespace: namespace1})
+			}
+		}
+	}
+
+	It("should move instances to a new service target config", func() {
+		addServiceTargetConfig(config1, 10)
+		addServiceTargetConfig(config2, 10)
+		addInstance("test-1", config1)
+		addInstance("test-2", config1)
+		addInstance("test-3", config1)
+
+		moves := rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)
+		Expect(moves).To(HaveLen(1))
+		Expect(moves[0].InstanceRef.Name).To(Equal("test-1"))
+		Expect(moves[0].SourceServiceTargetConfigRef.Name).To(Equal(config1))
+		Expect(moves[0].TargetServiceTargetConfigRef.Name).To(Equal(config2))
+		Expect(moves[0].Reason).To(ContainSubstring("score 10.00"))
+
+		// the input is not modified by the simulation
+		Expect(configs[0].Status.InstanceRefs).To(HaveLen(3))
+		Expect(configs[1].Status.InstanceRefs).To(BeEmpty())
+		Expect(instances[0].Spec.ServiceTargetConfigRef.Name).To(Equal(config1))
+	})
+
+	It("should only move instances if the score improves by the minimum score improvement", func() {
+		addServiceTargetConfig(config1, 10)
+		addServiceTargetConfig(config2, 11)
+		addInstance("test-1", config1)
+		addInstance("test-2", config1)
+		addInstance("test-3", config2)
+
+		// the score of config2 is higher by 10%
+		Expect(rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)).To(BeEmpty())
+
+		moves := rebalancing.ProposeMoves(nil, configs, instances, deployments, 5, 10)
+		Expect(moves).To(HaveLen(1))
+		Expect(moves[0].TargetServiceTargetConfigRef.Name).To(Equal(config2))
+	})
+
+	It("should move instances away from service target configs which are no longer selected by the scheduling rules", func() {
+		addServiceTargetConfig(config1, 10)
+		addServiceTargetConfig(config2, 10)
+		addInstance("test-1", config1)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 1,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config2, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		moves := rebalancing.ProposeMoves(scheduling, configs, instances, deployments, 20, 10)
+		Expect(moves).To(HaveLen(1))
+		Expect(moves[0].TargetServiceTargetConfigRef.Name).To(Equal(config2))
+		Expect(moves[0].Reason).To(ContainSubstring("not selected by the scheduling rules"))
+	})
+
+	It("should move instances away from service target configs without capacity", func() {
+		full := addServiceTargetConfig(config1, 10)
+		full.Spec.MaxInstances = ptr.To[int64](1)
+		addServiceTargetConfig(config2, 10)
+		addInstance("test-1", config1)
+		addInstance("test-2", config1)
+
+		moves := rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)
+		Expect(moves).To(HaveLen(1))
+		Expect(moves[0].TargetServiceTargetConfigRef.Name).To(Equal(config2))
+		Expect(moves[0].Reason).To(ContainSubstring(string(lssv1alpha1.SchedulingReasonNoCapacity)))
+	})
+
+	It("should propose at most the maximum number of moves", func() {
+		addServiceTargetConfig(config1, 10)
+		addServiceTargetConfig(config2, 10)
+		addServiceTargetConfig(config3, 10)
+		for _, name := range []string{"test-1", "test-2", "test-3", "test-4", "test-5", "test-6"} {
+			addInstance(name, config1)
+		}
+
+		Expect(rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)).To(HaveLen(4))
+		Expect(rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 1)).To(HaveLen(1))
+	})
+
+	It("should not move migrating instances and instances on cordoned service target configs", func() {
+		cordoned := addServiceTargetConfig(config1, 10)
+		cordoned.Spec.Cordon = &lssv1alpha1.Cordon{Reason: "cluster replacement"}
+		addServiceTargetConfig(config2, 10)
+		addServiceTargetConfig(config3, 10)
+		addInstance("test-1", config1)
+		addInstance("test-2", config1)
+		addInstance("test-3", config2)
+		addInstance("test-4", config2)
+		instances[2].Annotations = map[string]string{lssv1alpha1.InstanceMigrationAnnotation: namespace1 + "/" + config3}
+		instances[3].Annotations = map[string]string{lssv1alpha1.InstanceMigrationAnnotation: namespace1 + "/" + config3}
+
+		Expect(rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)).To(BeEmpty())
+	})
+
+	It("should not move instances which are not owned by a landscaper deployment", func() {
+		addServiceTargetConfig(config1, 10)
+		addServiceTargetConfig(config2, 10)
+		addInstance("test-1", config1)
+		addInstance("test-2", config1)
+		instances[0].OwnerReferences = nil
+
+		moves := rebalancing.ProposeMoves(nil, configs, instances, deployments, 20, 10)
+		Expect(moves).To(HaveLen(1))
+		Expect(moves[0].InstanceRef.Name).To(Equal("test-2"))
+	})
+})
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/controllers/rebalancing"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		ctx   context.Context
+		state *envtest.State
+		ctrl  *rebalancing.Controller
+		req   reconcile.Request
+		plan  *lssv1alpha1.RebalancePlan
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, name := range []string{"test1", "test2", "test3"} {
+			instance := state.GetInstance(name)
+			Expect(controllerutil.SetControllerReference(state.GetDeployment(name), instance, envtest.LandscaperServiceScheme)).To(Succeed())
+			Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+		}
+
+		cfg := testutils.DefaultControllerConfiguration()
+		cfg.Rebalancing = &config.RebalancingConfiguration{
+			PlanName:      "rebalancing",
+			PlanNamespace: state.Namespace,
+		}
+		config.SetDefaults_RebalancingConfiguration(cfg.Rebalancing)
+
+		op := operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, cfg)
+		ctrl = rebalancing.NewTestActuator(*op, logging.Discard())
+		req = reconcile.Request{NamespacedName: client.ObjectKey{Name: "rebalancing", Namespace: state.Namespace}}
+		plan = &lssv1alpha1.RebalancePlan{}
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if err := testenv.Client.Get(ctx, req.NamespacedName, plan); err == nil {
+			Expect(testenv.Client.Delete(ctx, plan)).To(Succeed())
+		}
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	// approve approves the proposed moves of the plan
+	approve := func() {
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		plan.Spec.Approved = true
+		Expect(testenv.Client.Update(ctx, plan)).To(Succeed())
+	}
+
+	// finishMigration simulates the migration of the instance by the instance controller
+	finishMigration := func(name, configName string) {
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(state.GetInstance(name)), instance)).To(Succeed())
+		delete(instance.Annotations, lssv1alpha1.InstanceMigrationAnnotation)
+		instance.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{Name: configName, Namespace: state.Namespace}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+	}
+
+	It("should create the rebalance plan and propose moves", func() {
+		result := testutils.ShouldReconcile(ctx, ctrl, req)
+		Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Spec.Approved).To(BeFalse())
+		Expect(plan.Spec.Moves).To(HaveLen(1))
+		Expect(plan.Spec.Moves[0].InstanceRef.Name).To(Equal("test1"))
+		Expect(plan.Spec.Moves[0].SourceServiceTargetConfigRef.Name).To(Equal("config1"))
+		Expect(plan.Spec.Moves[0].TargetServiceTargetConfigRef.Name).To(Equal("config2"))
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseProposed))
+		Expect(plan.Status.LastProposalTime).ToNot(BeNil())
+		lastProposalTime := plan.Status.LastProposalTime.DeepCopy()
+
+		// no new proposal is made before the interval has passed
+		result = testutils.ShouldReconcile(ctx, ctrl, req)
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Status.LastProposalTime.Equal(lastProposalTime)).To(BeTrue())
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(state.GetInstance("test1")), instance)).To(Succeed())
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.InstanceMigrationAnnotation))
+	})
+
+	It("should carry out the moves of an approved plan", func() {
+		testutils.ShouldReconcile(ctx, ctrl, req)
+		approve()
+
+		testutils.ShouldReconcile(ctx, ctrl, req)
+
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseProgressing))
+		Expect(plan.Status.Moves).To(HaveLen(1))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseMigrating))
+		Expect(plan.Status.LastMigrationTime).ToNot(BeNil())
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(state.GetInstance("test1")), instance)).To(Succeed())
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.InstanceMigrationAnnotation, state.Namespace+"/config2"))
+
+		finishMigration("test1", "config2")
+		testutils.ShouldReconcile(ctx, ctrl, req)
+
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseCompleted))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseSucceeded))
+	})
+
+	It("should skip moves to a cordoned service target config", func() {
+		testutils.ShouldReconcile(ctx, ctrl, req)
+		approve()
+
+		target := state.GetConfig("config2")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(target), target)).To(Succeed())
+		target.Spec.Cordon = &lssv1alpha1.Cordon{Reason: "cluster replacement"}
+		Expect(testenv.Client.Update(ctx, target)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, req)
+
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseCompleted))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseSkipped))
+		Expect(plan.Status.Moves[0].Message).To(ContainSubstring("cordoned"))
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(state.GetInstance("test1")), instance)).To(Succeed())
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.InstanceMigrationAnnotation))
+	})
+
+	It("should wait for the maintenance time window of the instance", func() {
+		testutils.ShouldReconcile(ctx, ctrl, req)
+		approve()
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(state.GetInstance("test1")), instance)).To(Succeed())
+		begin := time.Now().UTC().Add(2 * time.Hour)
+		instance.Spec.MaintenanceTimeWindow = &lssv1alpha1.MaintenanceTimeWindow{
+			Begin: begin.Format("150405") + "+0000",
+			End:   begin.Add(time.Hour).Format("150405") + "+0000",
+		}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		result := testutils.ShouldReconcile(ctx, ctrl, req)
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		Expect(testenv.Client.Get(ctx, req.NamespacedName, plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseProgressing))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhasePending))
+		Expect(plan.Status.Moves[0].Message).To(ContainSubstring("maintenance time window"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.InstanceMigrationAnnotation))
+	})
+})
@@ -6,9 +6,11 @@ package servicetargetconfigs
 
 import (
 	"context"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -19,24 +21,37 @@ import (
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
 // Controller is the servicetargetconfig controller
 type Controller struct {
 	operation.Operation
-	log logging.Logger
+	log                      logging.Logger
+	discoveryClientExtractor DiscoveryClientExtractorInterface
 }
 
 // NewController returns a new servicetargetconfig controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
-		log: logger,
+		log:                      logger,
+		discoveryClientExtractor: &DiscoveryClientExtractor{},
 	}
-	op := operation.NewOperation(c, scheme, config)
+	op := operation.NewOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
 
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, discoveryClientExtractor DiscoveryClientExtractorInterface, logger logging.Logger) *Controller {
+	ctrl := &Controller{
+		Operation:                op,
+		log:                      logger,
+		discoveryClientExtractor: discoveryClientExtractor,
+	}
+	return ctrl
+}
+
 // Reconcile reconciles requests for servicetargetconfigs
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
@@ -45,6 +60,7 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := c.Client().Get(ctx, req.NamespacedName, config); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
+			utils.SharedClientCache.Invalidate(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
@@ -71,12 +87,50 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	if !config.DeletionTimestamp.IsZero() {
 		// TODO: handle delete
+		utils.SharedClientCache.Invalidate(req.NamespacedName)
 		controllerutil.RemoveFinalizer(config, lssv1alpha1.LandscaperServiceFinalizer)
 		if err := c.Client().Update(ctx, config); err != nil {
 			return reconcile.Result{}, err
 		}
+		return reconcile.Result{}, nil
+	}
+
+	draining, err := c.drain(ctx, config)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// don't probe again if the spec has not changed and the probe interval has not yet passed
+	probeInterval := c.Config().ServiceTargetConfigProbe.PeriodicProbeInterval.Duration
+	if config.Status.LastProbeTime != nil && !hasSpecChanged(config) {
+		nextProbe := config.Status.LastProbeTime.Add(probeInterval)
+		if time.Now().Before(nextProbe) {
+			return requeueAfter(time.Until(nextProbe), draining), nil
+		}
+	}
+
+	if err := c.reconcile(ctx, config); err != nil {
+		return reconcile.Result{}, err
+	}
 
+	return requeueAfter(probeInterval, draining), nil
+}
+
+// requeueAfter returns the result to reconcile the ServiceTargetConfig again after the given duration,
+// or earlier if a drain is in progress.
+func requeueAfter(duration time.Duration, draining bool) reconcile.Result {
+	if draining && drainRetryDuration < duration {
+		duration = drainRetryDuration
 	}
+	return reconcile.Result{RequeueAfter: duration}
+}
 
-	return reconcile.Result{}, c.reconcile(ctx, config)
+// hasSpecChanged returns true if the spec has changed since the last probe.
+func hasSpecChanged(config *lssv1alpha1.ServiceTargetConfig) bool {
+	for _, condition := range config.Status.Conditions {
+		if condition.Type == lssv1alpha1.ServiceTargetConfigConditionReady {
+			return condition.ObservedGeneration != config.GetGeneration()
+		}
+	}
+	return true
 }
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// drainRetryDuration is the duration after which the progress of a drain is checked again.
+	drainRetryDuration = time.Second * 10
+)
+
+// drain migrates the instances of a cordoned ServiceTargetConfig to other ServiceTargetConfigs, if a drain is requested.
+// The migration of an instance is requested with the migration annotation, which is handled by the instance controller.
+// At most the configured number of instances are migrated at the same time.
+// The target ServiceTargetConfig of an instance is selected by the scheduling, as if its LandscaperDeployment was created again.
+// Returns true while the drain is in progress.
+func (c *Controller) drain(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) (bool, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "drain")
+
+	if config.Spec.Cordon == nil || config.Spec.Cordon.Drain == nil {
+		if config.Status.Drain != nil {
+			config.Status.Drain = nil
+			if err := c.Client().Status().Update(ctx, config); err != nil {
+				return false, fmt.Errorf("unable to reset drain status: %w", err)
+			}
+		}
+		return false, nil
+	}
+
+	oldStatus := config.Status.Drain.DeepCopy()
+	status := config.Status.Drain
+	if status == nil {
+		status = &lssv1alpha1.DrainStatus{
+			Phase:     lssv1alpha1.DrainPhaseDraining,
+			StartTime: metav1.Now(),
+		}
+	}
+
+	migrating := make([]lssv1alpha1.ObjectReference, 0)
+	waiting := make([]*lssv1alpha1.Instance, 0)
+	for _, ref := range config.Status.InstanceRefs {
+		instance := &lssv1alpha1.Instance{}
+		if err := c.Client().Get(ctx, ref.NamespacedName(), instance); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("unable to get instance %s: %w", ref.NamespacedName().String(), err)
+		}
+
+		if !instance.Spec.ServiceTargetConfigRef.IsObject(config) {
+			continue
+		}
+
+		if instance.IsMigrating() {
+			migrating = append(migrating, ref)
+		} else {
+			waiting = append(waiting, instance)
+		}
+	}
+
+	// the instances which have been migrating before and are no longer on this ServiceTargetConfig have been migrated,
+	// an instance whose migration failed or has been cancelled is still on this ServiceTargetConfig and is migrated again
+	for i := range status.MigratingInstances {
+		ref := &status.MigratingInstances[i]
+		if utils.ContainsReference(migrating, ref) {
+			continue
+		}
+		migrated, err := c.isMigrated(ctx, config, ref)
+		if err != nil {
+			return false, err
+		}
+		if migrated {
+			status.MigratedInstances++
+		}
+	}
+
+	remaining := len(migrating) + len(waiting)
+
+	maxConcurrentMigrations := 1
+	if config.Spec.Cordon.Drain.MaxConcurrentMigrations != nil {
+		maxConcurrentMigrations = int(*config.Spec.Cordon.Drain.MaxConcurrentMigrations)
+	}
+
+	messages := make([]string, 0)
+	for _, instance := range waiting {
+		if len(migrating) >= maxConcurrentMigrations {
+			break
+		}
+
+		target, err := c.findMigrationTarget(ctx, instance)
+		if err != nil {
+			logger.Info("unable to migrate instance", "instance", client.ObjectKeyFromObject(instance).String(), lc.KeyError, err.Error())
+			messages = append(messages, fmt.Sprintf("unable to migrate instance %s: %s", client.ObjectKeyFromObject(instance).String(), err.Error()))
+			continue
+		}
+
+		if instance.Annotations == nil {
+			instance.Annotations = map[string]string{}
+		}
+		instance.Annotations[lssv1alpha1.InstanceMigrationAnnotation] = fmt.Sprintf("%s/%s", target.Namespace, target.Name)
+		if err := c.Client().Update(ctx, instance); err != nil {
+			return false, fmt.Errorf("unable to request migration of instance %s: %w", client.ObjectKeyFromObject(instance).String(), err)
+		}
+
+		logger.Info("Requested migration of instance", "instance", client.ObjectKeyFromObject(instance).String(),
+			"target", client.ObjectKeyFromObject(target).String())
+		c.EventRecorder().Eventf(config, corev1.EventTypeNormal, operation.EventReasonMigrationRequested,
+			"Requested migration of instance %s to service target config %s", client.ObjectKeyFromObject(instance).String(),
+			client.ObjectKeyFromObject(target).String())
+		migrating = append(migrating, lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace})
+	}
+
+	status.RemainingInstances = remaining
+	status.MigratingInstances = migrating
+	status.Message = strings.Join(messages, "; ")
+	if remaining == 0 {
+		status.Phase = lssv1alpha1.DrainPhaseDrained
+	} else {
+		status.Phase = lssv1alpha1.DrainPhaseDraining
+	}
+
+	// avoid status updates without changes, as every update triggers another reconcile
+	if !equality.Semantic.DeepEqual(oldStatus, status) {
+		config.Status.Drain = status
+		if err := c.Client().Status().Update(ctx, config); err != nil {
+			return false, fmt.Errorf("unable to update drain status: %w", err)
+		}
+
+		if status.Phase == lssv1alpha1.DrainPhaseDrained && (oldStatus == nil || oldStatus.Phase != lssv1alpha1.DrainPhaseDrained) {
+			c.EventRecorder().Eventf(config, corev1.EventTypeNormal, operation.EventReasonDrained,
+				"Drained service target config, %d instances have been migrated", status.MigratedInstances)
+		}
+	}
+
+	return remaining > 0, nil
+}
+
+// isMigrated returns true if the referenced instance still exists and is no longer on the given ServiceTargetConfig.
+func (c *Controller) isMigrated(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, ref *lssv1alpha1.ObjectReference) (bool, error) {
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, ref.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to get instance %s: %w", ref.NamespacedName().String(), err)
+	}
+	return !instance.Spec.ServiceTargetConfigRef.IsObject(config), nil
+}
+
+// findMigrationTarget selects the ServiceTargetConfig to which an instance is migrated.
+// The scheduling is applied to the LandscaperDeployment which owns the instance.
+func (c *Controller) findMigrationTarget(ctx context.Context, instance *lssv1alpha1.Instance) (*lssv1alpha1.ServiceTargetConfig, error) {
+	ownerRef := metav1.GetControllerOf(instance)
+	if ownerRef == nil || ownerRef.Kind != "LandscaperDeployment" {
+		return nil, fmt.Errorf("instance is not owned by a landscaper deployment")
+	}
+
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if err := c.Client().Get(ctx, types.NamespacedName{Name: ownerRef.Name, Namespace: instance.Namespace}, deployment); err != nil {
+		return nil, fmt.Errorf("unable to get landscaper deployment %s: %w", ownerRef.Name, err)
+	}
+
+	var schedulingKey *client.ObjectKey
+	if scheduling := c.Config().Scheduling; scheduling != nil {
+		schedulingKey = &client.ObjectKey{Name: scheduling.Name, Namespace: scheduling.Namespace}
+	}
+
+	target, _, err := lssscheduling.ExplainScheduling(ctx, c.Client(), schedulingKey, deployment)
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
@@ -6,11 +6,175 @@ package servicetargetconfigs
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/operation"
+)
+
+const (
+	// ReasonProbeSucceeded is the condition reason when a probe has succeeded.
+	ReasonProbeSucceeded = "ProbeSucceeded"
+	// ReasonSecretInvalid is the condition reason when the kubeconfig secret can't be loaded.
+	ReasonSecretInvalid = "SecretInvalid"
+	// ReasonAPIServerUnreachable is the condition reason when the api server of the target cluster can't be reached.
+	ReasonAPIServerUnreachable = "APIServerUnreachable"
+	// ReasonLandscaperCRDsMissing is the condition reason when the landscaper CRDs aren't installed.
+	ReasonLandscaperCRDsMissing = "LandscaperCRDsMissing"
+	// ReasonProbeNotExecuted is the condition reason when a probe has been skipped because a previous probe failed.
+	ReasonProbeNotExecuted = "ProbeNotExecuted"
 )
 
-// reconcile reconciles a service target config.
-func (c *Controller) reconcile(_ context.Context, _ *lssv1alpha1.ServiceTargetConfig) error {
+// requiredLandscaperResources are the landscaper resources that have to be served by the target cluster.
+var requiredLandscaperResources = []string{"lshealthchecks"}
+
+// DiscoveryClientExtractorInterface creates a discovery client for the target cluster of a ServiceTargetConfig.
+type DiscoveryClientExtractorInterface interface {
+	GetDiscoveryClient(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, client client.Client, timeout time.Duration) (discovery.DiscoveryInterface, error)
+}
+
+// DiscoveryClientExtractor creates the discovery client from the kubeconfig secret referenced by the ServiceTargetConfig.
+type DiscoveryClientExtractor struct{}
+
+// GetDiscoveryClient loads the kubeconfig secret of the ServiceTargetConfig and creates a discovery client for it.
+func (e *DiscoveryClientExtractor) GetDiscoveryClient(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, c client.Client, timeout time.Duration) (discovery.DiscoveryInterface, error) {
+	secretRef := config.Spec.SecretRef
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, apitypes.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to load secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+
+	kubeconfigBytes, ok := secret.Data[secretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain key %q", secretRef.Namespace, secretRef.Name, secretRef.Key)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest config from kubeconfig: %w", err)
+	}
+	restConfig.Timeout = timeout
+
+	return discovery.NewDiscoveryClientForConfig(restConfig)
+}
+
+// reconcile probes the target cluster of a service target config and records the result as status conditions.
+func (c *Controller) reconcile(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "reconcile")
+
+	probeConfig := c.Config().ServiceTargetConfigProbe
+	reachable, crdsInstalled := c.probe(ctx, config, probeConfig.ProbeTimeout.Duration)
+
+	ready := metav1.Condition{
+		Type:    lssv1alpha1.ServiceTargetConfigConditionReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonProbeSucceeded,
+		Message: "target cluster is ready to host landscaper instances",
+	}
+	for _, condition := range []metav1.Condition{reachable, crdsInstalled} {
+		if condition.Status != metav1.ConditionTrue {
+			ready.Status = metav1.ConditionFalse
+			ready.Reason = condition.Reason
+			ready.Message = condition.Message
+			break
+		}
+	}
+
+	// the transitions of the ready condition are recorded as events, the first probe result only if it is not ready
+	wasReady := meta.FindStatusCondition(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)
+	if wasReady == nil || wasReady.Status != ready.Status {
+		if ready.Status != metav1.ConditionTrue {
+			c.EventRecorder().Eventf(config, corev1.EventTypeWarning, operation.EventReasonTargetClusterNotReady, "%s: %s", ready.Reason, ready.Message)
+		} else if wasReady != nil {
+			c.EventRecorder().Event(config, corev1.EventTypeNormal, operation.EventReasonTargetClusterReady, ready.Message)
+		}
+	}
+
+	for _, condition := range []metav1.Condition{reachable, crdsInstalled, ready} {
+		condition.ObservedGeneration = config.GetGeneration()
+		meta.SetStatusCondition(&config.Status.Conditions, condition)
+	}
+	now := metav1.Now()
+	config.Status.LastProbeTime = &now
+
+	if ready.Status != metav1.ConditionTrue {
+		logger.Info("target cluster is not ready", lc.KeyReason, ready.Reason, "message", ready.Message)
+	}
+
+	if err := c.Client().Status().Update(ctx, config); err != nil {
+		logger.Error(err, "failed to update status")
+		return err
+	}
+
 	return nil
 }
+
+// probe checks whether the api server of the target cluster is reachable and whether the landscaper CRDs are installed.
+func (c *Controller) probe(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, timeout time.Duration) (reachable, crdsInstalled metav1.Condition) {
+	reachable = metav1.Condition{
+		Type:   lssv1alpha1.ServiceTargetConfigConditionReachable,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonProbeSucceeded,
+	}
+	crdsInstalled = metav1.Condition{
+		Type:   lssv1alpha1.ServiceTargetConfigConditionLandscaperCRDsInstalled,
+		Status: metav1.ConditionFalse,
+		Reason: ReasonProbeNotExecuted,
+	}
+
+	discoveryClient, err := c.discoveryClientExtractor.GetDiscoveryClient(ctx, config, c.Client(), timeout)
+	if err != nil {
+		reachable.Status = metav1.ConditionFalse
+		reachable.Reason = ReasonSecretInvalid
+		reachable.Message = err.Error()
+		crdsInstalled.Message = "target cluster is not reachable"
+		return
+	}
+
+	serverVersion, err := discoveryClient.ServerVersion()
+	if err != nil {
+		reachable.Status = metav1.ConditionFalse
+		reachable.Reason = ReasonAPIServerUnreachable
+		reachable.Message = err.Error()
+		crdsInstalled.Message = "target cluster is not reachable"
+		return
+	}
+	reachable.Message = fmt.Sprintf("api server is reachable, version %s", serverVersion.GitVersion)
+
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(lsv1alpha1.SchemeGroupVersion.String())
+	if err != nil {
+		crdsInstalled.Reason = ReasonLandscaperCRDsMissing
+		crdsInstalled.Message = fmt.Sprintf("failed to discover %s resources: %s", lsv1alpha1.SchemeGroupVersion.String(), err.Error())
+		return
+	}
+
+	served := make(map[string]bool, len(resources.APIResources))
+	for _, resource := range resources.APIResources {
+		served[resource.Name] = true
+	}
+	for _, name := range requiredLandscaperResources {
+		if !served[name] {
+			crdsInstalled.Reason = ReasonLandscaperCRDsMissing
+			crdsInstalled.Message = fmt.Sprintf("resource %s.%s is not served by the target cluster", name, lsv1alpha1.SchemeGroupVersion.Group)
+			return
+		}
+	}
+
+	crdsInstalled.Status = metav1.ConditionTrue
+	crdsInstalled.Reason = ReasonProbeSucceeded
+	crdsInstalled.Message = "landscaper CRDs are installed"
+	return
+}
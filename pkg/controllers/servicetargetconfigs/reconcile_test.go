@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/controllers/servicetargetconfigs"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+type TestDiscoveryClientExtractor struct{}
+
+func (e *TestDiscoveryClientExtractor) GetDiscoveryClient(_ context.Context, _ *lssv1alpha1.ServiceTargetConfig, _ client.Client, _ time.Duration) (discovery.DiscoveryInterface, error) {
+	// return a discovery client for the test environment to fake a target cluster being the core cluster
+	return discovery.NewDiscoveryClientForConfig(testenv.Env.Config)
+}
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should report a reachable target cluster with landscaper CRDs as ready", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &TestDiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.LastProbeTime).ToNot(BeNil())
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReachable)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionLandscaperCRDsInstalled)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)).To(BeTrue())
+		Expect(config.IsNotReady()).To(BeFalse())
+	})
+
+	It("should report a target cluster with an invalid kubeconfig as not ready", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &servicetargetconfigs.DiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		ready := meta.FindStatusCondition(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(servicetargetconfigs.ReasonSecretInvalid))
+		Expect(config.IsNotReady()).To(BeTrue())
+	})
+
+	It("should not probe again before the probe interval has passed", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &TestDiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		lastProbeTime := config.Status.LastProbeTime.DeepCopy()
+
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.LastProbeTime.Equal(lastProbeTime)).To(BeTrue())
+	})
+
+	It("should drain a cordoned service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, name := range []string{"test1", "test2"} {
+			instance := state.GetInstance(name)
+			Expect(controllerutil.SetControllerReference(state.GetDeployment(name), instance, envtest.LandscaperServiceScheme)).To(Succeed())
+			Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+		}
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &TestDiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 10*time.Second))
+
+		// only one instance is migrated at the same time
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain).ToNot(BeNil())
+		Expect(config.Status.Drain.Phase).To(Equal(lssv1alpha1.DrainPhaseDraining))
+		Expect(config.Status.Drain.RemainingInstances).To(Equal(2))
+		Expect(config.Status.Drain.MigratingInstances).To(HaveLen(1))
+
+		migrating := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, config.Status.Drain.MigratingInstances[0].NamespacedName(), migrating)).To(Succeed())
+		Expect(migrating.Annotations).To(HaveKeyWithValue(lssv1alpha1.InstanceMigrationAnnotation, state.Namespace+"/config2"))
+
+		// finish the migration of the first instance
+		delete(migrating.Annotations, lssv1alpha1.InstanceMigrationAnnotation)
+		migrating.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{Name: "config2", Namespace: state.Namespace}
+		Expect(testenv.Client.Update(ctx, migrating)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain.Phase).To(Equal(lssv1alpha1.DrainPhaseDraining))
+		Expect(config.Status.Drain.RemainingInstances).To(Equal(1))
+		Expect(config.Status.Drain.MigratedInstances).To(Equal(1))
+		Expect(config.Status.Drain.MigratingInstances).To(HaveLen(1))
+		Expect(config.Status.Drain.MigratingInstances[0].Name).ToNot(Equal(migrating.Name))
+
+		// finish the migration of the second instance
+		Expect(testenv.Client.Get(ctx, config.Status.Drain.MigratingInstances[0].NamespacedName(), migrating)).To(Succeed())
+		Expect(migrating.Annotations).To(HaveKeyWithValue(lssv1alpha1.InstanceMigrationAnnotation, state.Namespace+"/config2"))
+		delete(migrating.Annotations, lssv1alpha1.InstanceMigrationAnnotation)
+		migrating.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{Name: "config2", Namespace: state.Namespace}
+		Expect(testenv.Client.Update(ctx, migrating)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain.Phase).To(Equal(lssv1alpha1.DrainPhaseDrained))
+		Expect(config.Status.Drain.RemainingInstances).To(Equal(0))
+		Expect(config.Status.Drain.MigratedInstances).To(Equal(2))
+		Expect(config.Status.Drain.MigratingInstances).To(BeEmpty())
+	})
+
+	It("should not count an instance whose migration failed as migrated", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, name := range []string{"test1", "test2"} {
+			instance := state.GetInstance(name)
+			Expect(controllerutil.SetControllerReference(state.GetDeployment(name), instance, envtest.LandscaperServiceScheme)).To(Succeed())
+			Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+		}
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &TestDiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain.MigratingInstances).To(HaveLen(1))
+
+		// the migration fails, the instance stays on this service target config
+		migrating := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, config.Status.Drain.MigratingInstances[0].NamespacedName(), migrating)).To(Succeed())
+		delete(migrating.Annotations, lssv1alpha1.InstanceMigrationAnnotation)
+		Expect(testenv.Client.Update(ctx, migrating)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain.Phase).To(Equal(lssv1alpha1.DrainPhaseDraining))
+		Expect(config.Status.Drain.RemainingInstances).To(Equal(2))
+		Expect(config.Status.Drain.MigratedInstances).To(Equal(0))
+	})
+
+	It("should reset the drain status when the service target config is uncordoned", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		ctrl := servicetargetconfigs.NewTestActuator(*op, &TestDiscoveryClientExtractor{}, logging.Discard())
+
+		config := state.GetConfig("config1")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		// the instances are not owned by a landscaper deployment, therefore no migration target can be found
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain).ToNot(BeNil())
+		Expect(config.Status.Drain.MigratingInstances).To(BeEmpty())
+		Expect(config.Status.Drain.Message).To(ContainSubstring("unable to migrate instance"))
+
+		config.Spec.Cordon = nil
+		Expect(testenv.Client.Update(ctx, config)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.Drain).To(BeNil())
+	})
+})
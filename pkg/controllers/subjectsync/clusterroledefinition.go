@@ -10,6 +10,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 type ClusterRoleDefinition struct {
@@ -95,7 +96,9 @@ func (r *ClusterRoleDefinition) CreateOrUpdateClusterRole(ctx context.Context, c
 	return nil
 }
 
-func (r *ClusterRoleDefinition) CreateOrUpdateClusterRoleBinding(ctx context.Context, cl client.Client, subjects []rbacv1.Subject) error {
+// CreateOrUpdateClusterRoleBinding ensures the cluster role binding with the given subjects.
+// It returns whether the cluster role binding has been created or changed.
+func (r *ClusterRoleDefinition) CreateOrUpdateClusterRoleBinding(ctx context.Context, cl client.Client, subjects []rbacv1.Subject) (bool, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	roleBinding := &rbacv1.ClusterRoleBinding{
@@ -104,7 +107,7 @@ func (r *ClusterRoleDefinition) CreateOrUpdateClusterRoleBinding(ctx context.Con
 		},
 	}
 
-	_, err := kutils.CreateOrUpdate(ctx, cl, roleBinding, func() error {
+	result, err := kutils.CreateOrUpdate(ctx, cl, roleBinding, func() error {
 		roleBinding.RoleRef = rbacv1.RoleRef{
 			APIGroup: "rbac.authorization.k8s.io",
 			Kind:     "ClusterRole",
@@ -115,8 +118,8 @@ func (r *ClusterRoleDefinition) CreateOrUpdateClusterRoleBinding(ctx context.Con
 	})
 	if err != nil {
 		logger.Error(err, "failed ensuring cluster role binding", lc.KeyResource, r.bindingName)
-		return fmt.Errorf("failed ensuring cluster role binding %s: %w", r.bindingName, err)
+		return false, fmt.Errorf("failed ensuring cluster role binding %s: %w", r.bindingName, err)
 	}
 
-	return nil
+	return result != controllerutil.OperationResultNone, nil
 }
@@ -10,10 +10,12 @@ import (
 
 	kutils "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -30,12 +32,12 @@ type Controller struct {
 	ReconcileFunc func(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 	ctrl.ReconcileFunc = ctrl.reconcile
-	op := operation.NewTargetShootSidecarOperation(c, scheme, config)
+	op := operation.NewTargetShootSidecarOperation(c, scheme, config).WithEventRecorder(recorder)
 	ctrl.TargetShootSidecarOperation = *op
 	return ctrl, nil
 }
@@ -77,7 +79,11 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	return c.reconcile(ctx, subjectList)
+	result, err := c.reconcile(ctx, subjectList)
+	if err != nil {
+		c.EventRecorder().Event(subjectList, corev1.EventTypeWarning, operation.EventReasonSubjectSyncFailed, err.Error())
+	}
+	return result, err
 }
 
 func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, error) {
@@ -94,7 +100,8 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 		return reconcile.Result{}, err
 	}
 
-	if err := userClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), subjects); err != nil {
+	userBindingUpdated, err := userClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), subjects)
+	if err != nil {
 		logger.Error(err, "failed updating user cluster role binding")
 		return reconcile.Result{}, err
 	}
@@ -106,11 +113,13 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 		return reconcile.Result{}, err
 	}
 
-	if err := viewerClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), viewerSubjects); err != nil {
+	viewerBindingUpdated, err := viewerClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), viewerSubjects)
+	if err != nil {
 		logger.Error(err, "failed updating viewer cluster role binding")
 		return reconcile.Result{}, err
 	}
 
+	updated := 0
 	roleBindings := &rbacv1.RoleBindingList{}
 	if err := c.Client().List(ctx, roleBindings); err != nil {
 		logger.Error(err, "failed loading role bindings")
@@ -127,9 +136,13 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects); err != nil {
+			bindingUpdated, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects)
+			if err != nil {
 				return reconcile.Result{}, err
 			}
+			if bindingUpdated {
+				updated++
+			}
 
 		case USER_ROLE_BINDING_IN_NAMESPACE:
 			if !strings.HasPrefix(roleBinding.Namespace, CUSTOM_NS_PREFIX) {
@@ -137,9 +150,13 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects); err != nil {
+			bindingUpdated, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects)
+			if err != nil {
 				return reconcile.Result{}, err
 			}
+			if bindingUpdated {
+				updated++
+			}
 
 		case VIEWER_ROLE_BINDING_IN_NAMESPACE:
 			if !strings.HasPrefix(roleBinding.Namespace, CUSTOM_NS_PREFIX) {
@@ -147,11 +164,27 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, viewerSubjects); err != nil {
+			bindingUpdated, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, viewerSubjects)
+			if err != nil {
 				return reconcile.Result{}, err
 			}
+			if bindingUpdated {
+				updated++
+			}
 		}
 	}
 
+	// the event is only recorded if the subjects have actually changed, not on every periodic reconcile
+	clusterRoleBindingsUpdated := 0
+	for _, bindingUpdated := range []bool{userBindingUpdated, viewerBindingUpdated} {
+		if bindingUpdated {
+			clusterRoleBindingsUpdated++
+		}
+	}
+	if clusterRoleBindingsUpdated > 0 || updated > 0 {
+		c.EventRecorder().Eventf(subjectList, corev1.EventTypeNormal, operation.EventReasonSubjectsSynced,
+			"Synchronized %d subjects and %d viewer subjects into %d cluster role bindings and %d role bindings",
+			len(subjects), len(viewerSubjects), clusterRoleBindingsUpdated, updated)
+	}
 	return reconcile.Result{}, nil
 }
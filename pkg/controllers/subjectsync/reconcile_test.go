@@ -6,6 +6,7 @@ package subjectsync_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -17,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
@@ -189,6 +191,39 @@ var _ = Describe("Reconcile", func() {
 		Expect(reflect.DeepEqual(viewerClusterRole.Rules, expectedViewerRules)).To(BeTrue())
 	})
 
+	It("should only record an event if the role bindings have been updated", func() {
+		var err error
+
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(10)
+		ctrl = subjectsync.NewTestActuator(*op.WithEventRecorder(recorder), logging.Discard())
+
+		subjectlist := state.GetSubjectList(subjectsync.SUBJECT_LIST_NAME)
+		//reconcile for finalizer
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		//reconcile for actual run
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		// the cluster role bindings may have been synchronized by a previous test already
+		Expect(recorder.Events).To(Receive(MatchRegexp(fmt.Sprintf(
+			"^Normal %s Synchronized 3 subjects and 3 viewer subjects into [0-2] cluster role bindings and 3 role bindings$",
+			operation.EventReasonSubjectsSynced))))
+
+		//reconcile without changes
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		Expect(recorder.Events).ToNot(Receive())
+
+		//delete group
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subjectlist), subjectlist)).To(Succeed())
+		subjectlist.Spec.Subjects = append(subjectlist.Spec.Subjects[:1], subjectlist.Spec.Subjects[2:]...)
+		Expect(testenv.Client.Update(ctx, subjectlist)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf(
+			"Normal %s Synchronized 2 subjects and 3 viewer subjects into 1 cluster role bindings and 2 role bindings",
+			operation.EventReasonSubjectsSynced))))
+	})
+
 	It("should skip unknown/erroneous subjects", func() {
 		var err error
 
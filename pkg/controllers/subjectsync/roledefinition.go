@@ -8,6 +8,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -193,16 +194,22 @@ func (r *RoleDefinition) CreateRoleBindingWithoutSubjectsIfNotExist(ctx context.
 	return nil
 }
 
-func UpdateRoleBindingSubjects(ctx context.Context, cl client.Client, binding *rbacv1.RoleBinding, subjects []rbacv1.Subject) error {
+// UpdateRoleBindingSubjects sets the subjects of the role binding.
+// It returns whether the role binding had to be updated.
+func UpdateRoleBindingSubjects(ctx context.Context, cl client.Client, binding *rbacv1.RoleBinding, subjects []rbacv1.Subject) (bool, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
+	if equality.Semantic.DeepEqual(binding.Subjects, subjects) {
+		return false, nil
+	}
+
 	binding.Subjects = subjects
 	if err := cl.Update(ctx, binding); err != nil {
 		logger.Error(err, "failed updating role binding")
-		return fmt.Errorf("failed updating role binding %s %s: %w", binding.Namespace, binding.Name, err)
+		return false, fmt.Errorf("failed updating role binding %s %s: %w", binding.Namespace, binding.Name, err)
 	}
 
-	return nil
+	return true, nil
 }
 
 func (r *RoleDefinition) DeleteRole(ctx context.Context, cl client.Client) error {
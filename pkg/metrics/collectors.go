@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// collectTimeout is the maximum duration of the list calls of a collector.
+	collectTimeout = time.Second * 10
+
+	// PhaseUnknown is the phase label value of an object whose phase has not been set yet.
+	PhaseUnknown = "Unknown"
+)
+
+var (
+	instancesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "instances"),
+		"Number of instances per phase, tenant and service target config.",
+		[]string{"phase", "tenant_id", "service_target_config"}, nil,
+	)
+
+	namespaceRegistrationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "namespace_registrations"),
+		"Number of namespace registrations per phase.",
+		[]string{"phase"}, nil,
+	)
+)
+
+// InstanceCollector collects the number of instances per phase, tenant and service target config.
+// The instances are listed whenever the metrics are scraped.
+type InstanceCollector struct {
+	client client.Client
+}
+
+// NewInstanceCollector creates a new instance collector.
+func NewInstanceCollector(c client.Client) *InstanceCollector {
+	return &InstanceCollector{client: c}
+}
+
+// Describe implements prometheus.Collector.
+func (c *InstanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- instancesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *InstanceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	instances := &lssv1alpha1.InstanceList{}
+	if err := c.client.List(ctx, instances); err != nil {
+		ch <- prometheus.NewInvalidMetric(instancesDesc, err)
+		return
+	}
+
+	type key struct {
+		phase, tenantId, serviceTargetConfig string
+	}
+	counts := map[key]int{}
+	for _, instance := range instances.Items {
+		counts[key{
+			phase:               phaseOrUnknown(instance.Status.Phase),
+			tenantId:            instance.Spec.TenantId,
+			serviceTargetConfig: instance.Spec.ServiceTargetConfigRef.NamespacedName().String(),
+		}]++
+	}
+
+	for k, count := range counts {
+		ch <- prometheus.MustNewConstMetric(instancesDesc, prometheus.GaugeValue, float64(count), k.phase, k.tenantId, k.serviceTargetConfig)
+	}
+}
+
+// NamespaceRegistrationCollector collects the number of namespace registrations per phase.
+// The namespace registrations are listed whenever the metrics are scraped.
+type NamespaceRegistrationCollector struct {
+	client client.Client
+}
+
+// NewNamespaceRegistrationCollector creates a new namespace registration collector.
+func NewNamespaceRegistrationCollector(c client.Client) *NamespaceRegistrationCollector {
+	return &NamespaceRegistrationCollector{client: c}
+}
+
+// Describe implements prometheus.Collector.
+func (c *NamespaceRegistrationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- namespaceRegistrationsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *NamespaceRegistrationCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	namespaceRegistrations := &lssv1alpha1.NamespaceRegistrationList{}
+	if err := c.client.List(ctx, namespaceRegistrations); err != nil {
+		ch <- prometheus.NewInvalidMetric(namespaceRegistrationsDesc, err)
+		return
+	}
+
+	counts := map[string]int{}
+	for _, namespaceRegistration := range namespaceRegistrations.Items {
+		counts[phaseOrUnknown(namespaceRegistration.Status.Phase)]++
+	}
+
+	for phase, count := range counts {
+		ch <- prometheus.MustNewConstMetric(namespaceRegistrationsDesc, prometheus.GaugeValue, float64(count), phase)
+	}
+}
+
+func phaseOrUnknown(phase string) string {
+	if phase == "" {
+		return PhaseUnknown
+	}
+	return phase
+}
+
+// RegisterInstanceCollector registers the instance collector at the controller-runtime metrics registry.
+func RegisterInstanceCollector(c client.Client) error {
+	return metrics.Registry.Register(NewInstanceCollector(c))
+}
+
+// RegisterNamespaceRegistrationCollector registers the namespace registration collector at the controller-runtime metrics registry.
+func RegisterNamespaceRegistrationCollector(c client.Client) error {
+	return metrics.Registry.Register(NewNamespaceRegistrationCollector(c))
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// Namespace is the namespace of all landscaper service metrics.
+	Namespace = "landscaper_service"
+
+	// InstanceStepContext is the reconcile step of an instance in which the landscaper context is reconciled.
+	InstanceStepContext = "context"
+	// InstanceStepTarget is the reconcile step of an instance in which the targets are reconciled.
+	InstanceStepTarget = "target"
+	// InstanceStepInstallation is the reconcile step of an instance in which the installation is reconciled.
+	InstanceStepInstallation = "installation"
+	// InstanceStepExports is the reconcile step of an instance in which the exports of the installation are handled.
+	InstanceStepExports = "exports"
+
+	// ResultSuccess is the result label value of a successful operation.
+	ResultSuccess = "success"
+	// ResultFailure is the result label value of a failed operation.
+	ResultFailure = "failure"
+
+	// SchedulingReasonNoCandidates is the reason of a scheduling failure when no service target config has been considered at all.
+	SchedulingReasonNoCandidates = "NoCandidates"
+)
+
+var (
+	// InstanceReconcileStepDuration is the duration of the reconcile steps of an instance.
+	InstanceReconcileStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: "instance",
+			Name:      "reconcile_step_duration_seconds",
+			Help:      "Duration of the reconcile steps of an instance.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+		[]string{"step", "result"},
+	)
+
+	// SchedulingFailures counts the landscaper deployments which could not be scheduled, by the reason
+	// for which the service target configs have been filtered out.
+	SchedulingFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "scheduling",
+			Name:      "failures_total",
+			Help:      "Number of failed schedulings of landscaper deployments by reason.",
+		},
+		[]string{"reason"},
+	)
+
+	// HealthCheckStatus is the result of the last health check of an instance, 1 if the landscaper is available, 0 otherwise.
+	HealthCheckStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "healthwatcher",
+			Name:      "instance_available",
+			Help:      "Availability of the landscaper of an instance (1 = available, 0 = unavailable).",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// HealthCheckDuration is the duration of the last health check of an instance.
+	HealthCheckDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "healthwatcher",
+			Name:      "instance_check_duration_seconds",
+			Help:      "Duration of the last health check of the landscaper of an instance.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// InstanceAvailability is the availability of an instance in percent within a rolling time window.
+	InstanceAvailability = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "healthwatcher",
+			Name:      "instance_availability_percent",
+			Help:      "Availability of the landscaper of an instance in percent within a rolling time window.",
+		},
+		[]string{"namespace", "name", "window"},
+	)
+
+	// AvsUploads counts the uploads to the availability service by result.
+	AvsUploads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "avs",
+			Name:      "uploads_total",
+			Help:      "Number of uploads to the availability service by result.",
+		},
+		[]string{"result"},
+	)
+
+	// AvailabilitySinkBacklog is the number of health watcher runs which have not yet been reported to an availability sink.
+	AvailabilitySinkBacklog = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "availability_sink",
+			Name:      "backlog_size",
+			Help:      "Number of health watcher runs which have not yet been reported to an availability sink.",
+		},
+		[]string{"sink"},
+	)
+
+	// AvsUploadDuration is the duration of the uploads to the availability service.
+	AvsUploadDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: "avs",
+			Name:      "upload_duration_seconds",
+			Help:      "Duration of the uploads to the availability service.",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		InstanceReconcileStepDuration,
+		SchedulingFailures,
+		HealthCheckStatus,
+		HealthCheckDuration,
+		InstanceAvailability,
+		AvsUploads,
+		AvsUploadDuration,
+		AvailabilitySinkBacklog,
+	)
+}
+
+// Result returns the result label value for the given error.
+func Result(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+	return ResultSuccess
+}
+
+// ObserveInstanceReconcileStep records the duration of an instance reconcile step which has been started at the given time.
+func ObserveInstanceReconcileStep(step string, start time.Time, err error) {
+	InstanceReconcileStepDuration.WithLabelValues(step, Result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveAvsUpload records the result and the duration of an upload to the availability service.
+func ObserveAvsUpload(start time.Time, err error) {
+	AvsUploads.WithLabelValues(Result(err)).Inc()
+	AvsUploadDuration.Observe(time.Since(start).Seconds())
+}
+
+// RecordSchedulingFailure counts a failed scheduling once for every distinct reason
+// for which a service target config has been filtered out.
+func RecordSchedulingFailure(explanation *lssv1alpha1.SchedulingExplanation) {
+	reasons := map[string]bool{}
+	if explanation != nil {
+		for _, filtered := range explanation.FilteredOut {
+			reasons[filtered.Reason] = true
+		}
+	}
+	if len(reasons) == 0 {
+		reasons[SchedulingReasonNoCandidates] = true
+	}
+	for reason := range reasons {
+		SchedulingFailures.WithLabelValues(reason).Inc()
+	}
+}
+
+// SetHealthCheckStatus records the health check result and duration of an instance.
+func SetHealthCheckStatus(ref lssv1alpha1.ObjectReference, available bool, duration time.Duration) {
+	value := 0.0
+	if available {
+		value = 1.0
+	}
+	HealthCheckStatus.WithLabelValues(ref.Namespace, ref.Name).Set(value)
+	HealthCheckDuration.WithLabelValues(ref.Namespace, ref.Name).Set(duration.Seconds())
+}
+
+// SetInstanceAvailability records the availability of an instance in percent within the given window.
+func SetInstanceAvailability(ref lssv1alpha1.ObjectReference, window string, percent float64) {
+	InstanceAvailability.WithLabelValues(ref.Namespace, ref.Name, window).Set(percent)
+}
+
+// DeleteHealthCheckStatus removes the health check metrics of an instance which is no longer watched.
+func DeleteHealthCheckStatus(ref lssv1alpha1.ObjectReference) {
+	HealthCheckStatus.DeleteLabelValues(ref.Namespace, ref.Name)
+	HealthCheckDuration.DeleteLabelValues(ref.Namespace, ref.Name)
+	InstanceAvailability.DeletePartialMatch(prometheus.Labels{"namespace": ref.Namespace, "name": ref.Name})
+}
+
+// SetAvailabilitySinkBacklog records the number of health watcher runs which have not yet been reported to an availability sink.
+func SetAvailabilitySinkBacklog(sink string, size int) {
+	AvailabilitySinkBacklog.WithLabelValues(sink).Set(float64(size))
+}
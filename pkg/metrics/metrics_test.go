@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Metrics", func() {
+
+	newInstance := func(name, tenantId, configName, phase string) *lssv1alpha1.Instance {
+		return &lssv1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: lssv1alpha1.InstanceSpec{
+				TenantId:               tenantId,
+				ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: configName, Namespace: "laas-system"},
+			},
+			Status: lssv1alpha1.InstanceStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	It("should count the instances per phase, tenant and service target config", func() {
+		c := fake.NewClientBuilder().WithScheme(envtest.LandscaperServiceScheme).WithObjects(
+			newInstance("test1", "tenant1", "config1", "Succeeded"),
+			newInstance("test2", "tenant1", "config1", "Succeeded"),
+			newInstance("test3", "tenant2", "config1", "Failed"),
+			newInstance("test4", "tenant2", "config2", ""),
+		).Build()
+
+		expected := `
+# HELP landscaper_service_instances Number of instances per phase, tenant and service target config.
+# TYPE landscaper_service_instances gauge
+landscaper_service_instances{phase="Failed",service_target_config="laas-system/config1",tenant_id="tenant2"} 1
+landscaper_service_instances{phase="Succeeded",service_target_config="laas-system/config1",tenant_id="tenant1"} 2
+landscaper_service_instances{phase="Unknown",service_target_config="laas-system/config2",tenant_id="tenant2"} 1
+`
+		Expect(testutil.CollectAndCompare(metrics.NewInstanceCollector(c), strings.NewReader(expected))).To(Succeed())
+	})
+
+	It("should count the namespace registrations per phase", func() {
+		c := fake.NewClientBuilder().WithScheme(envtest.LandscaperServiceScheme).WithObjects(
+			&lssv1alpha1.NamespaceRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "cu-test1", Namespace: "ls-user"},
+				Status:     lssv1alpha1.NamespaceRegistrationStatus{Phase: "Completed"},
+			},
+			&lssv1alpha1.NamespaceRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "cu-test2", Namespace: "ls-user"},
+				Status:     lssv1alpha1.NamespaceRegistrationStatus{Phase: "Completed"},
+			},
+		).Build()
+
+		expected := `
+# HELP landscaper_service_namespace_registrations Number of namespace registrations per phase.
+# TYPE landscaper_service_namespace_registrations gauge
+landscaper_service_namespace_registrations{phase="Completed"} 2
+`
+		Expect(testutil.CollectAndCompare(metrics.NewNamespaceRegistrationCollector(c), strings.NewReader(expected))).To(Succeed())
+	})
+
+	It("should count a scheduling failure once per filter reason", func() {
+		noCapacity := testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(lssv1alpha1.SchedulingReasonNoCapacity))
+		cordoned := testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(lssv1alpha1.SchedulingReasonCordoned))
+		noCandidates := testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(metrics.SchedulingReasonNoCandidates))
+
+		metrics.RecordSchedulingFailure(&lssv1alpha1.SchedulingExplanation{
+			FilteredOut: []lssv1alpha1.FilteredServiceTargetConfig{
+				{ObjectReference: lssv1alpha1.ObjectReference{Name: "config1"}, Reason: lssv1alpha1.SchedulingReasonNoCapacity},
+				{ObjectReference: lssv1alpha1.ObjectReference{Name: "config2"}, Reason: lssv1alpha1.SchedulingReasonNoCapacity},
+				{ObjectReference: lssv1alpha1.ObjectReference{Name: "config3"}, Reason: lssv1alpha1.SchedulingReasonCordoned},
+			},
+		})
+		metrics.RecordSchedulingFailure(nil)
+
+		Expect(testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(lssv1alpha1.SchedulingReasonNoCapacity))).To(Equal(noCapacity + 1))
+		Expect(testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(lssv1alpha1.SchedulingReasonCordoned))).To(Equal(cordoned + 1))
+		Expect(testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(metrics.SchedulingReasonNoCandidates))).To(Equal(noCandidates + 1))
+	})
+
+	It("should set and delete the health check status of an instance", func() {
+		ref := lssv1alpha1.ObjectReference{Name: "test1", Namespace: "test"}
+
+		metrics.SetHealthCheckStatus(ref, false, 2*time.Second)
+		Expect(testutil.ToFloat64(metrics.HealthCheckStatus.WithLabelValues("test", "test1"))).To(Equal(0.0))
+		Expect(testutil.ToFloat64(metrics.HealthCheckDuration.WithLabelValues("test", "test1"))).To(Equal(2.0))
+
+		metrics.SetHealthCheckStatus(ref, true, time.Second)
+		Expect(testutil.ToFloat64(metrics.HealthCheckStatus.WithLabelValues("test", "test1"))).To(Equal(1.0))
+
+		metrics.DeleteHealthCheckStatus(ref)
+		Expect(testutil.CollectAndCount(metrics.HealthCheckStatus)).To(Equal(0))
+		Expect(testutil.CollectAndCount(metrics.HealthCheckDuration)).To(Equal(0))
+	})
+})
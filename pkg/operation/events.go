@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+// Reasons of the events which are recorded by the landscaper service controllers.
+const (
+	// EventReasonReconcileFailed is recorded when the reconciliation of an object failed.
+	EventReasonReconcileFailed = "ReconcileFailed"
+	// EventReasonDeleting is recorded when the deletion of a dependent object is triggered.
+	EventReasonDeleting = "Deleting"
+	// EventReasonDeletionFailed is recorded when the deletion of an object failed.
+	EventReasonDeletionFailed = "DeletionFailed"
+
+	// EventReasonScheduled is recorded when a ServiceTargetConfig has been selected for a LandscaperDeployment.
+	EventReasonScheduled = "Scheduled"
+	// EventReasonSchedulingFailed is recorded when no ServiceTargetConfig can be selected for a LandscaperDeployment.
+	EventReasonSchedulingFailed = "SchedulingFailed"
+	// EventReasonInstanceCreated is recorded when the Instance of a LandscaperDeployment has been created.
+	EventReasonInstanceCreated = "InstanceCreated"
+
+	// EventReasonShootNameAllocated is recorded when the shoot name of an Instance has been allocated.
+	EventReasonShootNameAllocated = "ShootNameAllocated"
+	// EventReasonInstallationCreated is recorded when the Installation of an Instance has been created.
+	EventReasonInstallationCreated = "InstallationCreated"
+	// EventReasonMigrating is recorded when the migration of an Instance enters a new phase.
+	EventReasonMigrating = "Migrating"
+	// EventReasonMigrated is recorded when the migration of an Instance has succeeded.
+	EventReasonMigrated = "Migrated"
+	// EventReasonAvailable is recorded when the landscaper of an Instance has become available again.
+	EventReasonAvailable = "Available"
+	// EventReasonUnavailable is recorded when the landscaper of an Instance has become unavailable.
+	EventReasonUnavailable = "Unavailable"
+
+	// EventReasonTargetClusterReady is recorded when the target cluster of a ServiceTargetConfig has become ready.
+	EventReasonTargetClusterReady = "TargetClusterReady"
+	// EventReasonTargetClusterNotReady is recorded when the target cluster of a ServiceTargetConfig has become not ready.
+	EventReasonTargetClusterNotReady = "TargetClusterNotReady"
+	// EventReasonMigrationRequested is recorded when the migration of an Instance has been requested by a drain or a rebalancing.
+	EventReasonMigrationRequested = "MigrationRequested"
+	// EventReasonDrained is recorded when all Instances have been migrated away from a cordoned ServiceTargetConfig.
+	EventReasonDrained = "Drained"
+
+	// EventReasonRebalanceProposed is recorded when new moves have been proposed in a RebalancePlan.
+	EventReasonRebalanceProposed = "RebalanceProposed"
+	// EventReasonRebalanceCompleted is recorded when all moves of an approved RebalancePlan are finished.
+	EventReasonRebalanceCompleted = "RebalanceCompleted"
+
+	// EventReasonMonitoringUpdated is recorded when the monitored Instances of an AvailabilityCollection have changed.
+	EventReasonMonitoringUpdated = "MonitoringUpdated"
+	// EventReasonAvailabilityUploadFailed is recorded when the availability could not be uploaded to the availability service.
+	EventReasonAvailabilityUploadFailed = "AvailabilityUploadFailed"
+
+	// EventReasonNamespaceRegistered is recorded when the namespace of a NamespaceRegistration has been created and configured.
+	EventReasonNamespaceRegistered = "NamespaceRegistered"
+	// EventReasonNamespaceRegistrationFailed is recorded when the namespace of a NamespaceRegistration could not be created or configured.
+	EventReasonNamespaceRegistrationFailed = "NamespaceRegistrationFailed"
+
+	// EventReasonSubjectsSynced is recorded when the subjects of a SubjectList have been synchronized into the role bindings.
+	EventReasonSubjectsSynced = "SubjectsSynced"
+	// EventReasonSubjectSyncFailed is recorded when the subjects of a SubjectList could not be synchronized.
+	EventReasonSubjectSyncFailed = "SubjectSyncFailed"
+)
@@ -6,6 +6,7 @@ package operation
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
@@ -19,6 +20,8 @@ type Operation struct {
 	scheme *runtime.Scheme
 	// config is the configuration for the landscaper service controller
 	config *v1alpha1.LandscaperServiceConfiguration
+	// eventRecorder records the events of the controller
+	eventRecorder record.EventRecorder
 }
 
 // NewOperation creates a new Operation for the given values.
@@ -43,3 +46,18 @@ func (o *Operation) Scheme() *runtime.Scheme {
 func (o *Operation) Config() *v1alpha1.LandscaperServiceConfiguration {
 	return o.config
 }
+
+// WithEventRecorder sets the event recorder which is used to record events for the reconciled objects.
+func (o *Operation) WithEventRecorder(recorder record.EventRecorder) *Operation {
+	o.eventRecorder = recorder
+	return o
+}
+
+// EventRecorder returns the event recorder.
+// Events are discarded if no event recorder has been set.
+func (o *Operation) EventRecorder() record.EventRecorder {
+	if o.eventRecorder == nil {
+		return &record.FakeRecorder{}
+	}
+	return o.eventRecorder
+}
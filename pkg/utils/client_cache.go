@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SharedClientCache is the client cache for the hosting clusters of the ServiceTargetConfigs.
+// Its clients are shared by the health watcher and the instance controller, which uses them for the hibernation
+// and the deletion of instances. The service target config controller invalidates them.
+var SharedClientCache = NewClientCache()
+
+// ClientCache caches the clients for the hosting clusters of the ServiceTargetConfigs.
+// A client is reused as long as the kubeconfig secret of the ServiceTargetConfig has not changed,
+// so that the REST mapping and the connections to the hosting cluster are not set up again for every request.
+// A rotation of the credentials changes the resource version of the secret and thereby replaces the client.
+type ClientCache struct {
+	mutex   sync.Mutex
+	entries map[types.NamespacedName]*clientCacheEntry
+
+	// newClient creates the client for a kubeconfig, it can be replaced for testing.
+	newClient func(kubeconfig []byte) (client.Client, error)
+}
+
+// clientCacheEntry is a cached client together with the version of the secret it has been created from.
+type clientCacheEntry struct {
+	secret          types.NamespacedName
+	secretKey       string
+	resourceVersion string
+	client          client.Client
+}
+
+// NewClientCache creates a new client cache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{
+		entries:   map[types.NamespacedName]*clientCacheEntry{},
+		newClient: newClientForKubeconfig,
+	}
+}
+
+// NewTestClientCache creates a new client cache with a custom client constructor for testing purposes.
+func NewTestClientCache(newClient func(kubeconfig []byte) (client.Client, error)) *ClientCache {
+	cache := NewClientCache()
+	cache.newClient = newClient
+	return cache
+}
+
+// GetOrCreate returns the client for the hosting cluster of a ServiceTargetConfig.
+// The cached client is returned if it has been created from the same version of the secret,
+// otherwise a new client is created from the kubeconfig in the given key of the secret.
+func (c *ClientCache) GetOrCreate(serviceTargetConfig types.NamespacedName, secret *corev1.Secret, secretKey string) (client.Client, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	secretName := client.ObjectKeyFromObject(secret)
+	if entry, ok := c.entries[serviceTargetConfig]; ok {
+		if entry.secret == secretName && entry.secretKey == secretKey && entry.resourceVersion == secret.ResourceVersion {
+			return entry.client, nil
+		}
+		delete(c.entries, serviceTargetConfig)
+	}
+
+	kubeconfig, ok := secret.Data[secretKey]
+	if !ok {
+		return nil, fmt.Errorf("could not found key %s in secret", secretKey)
+	}
+
+	kubeClient, err := c.newClient(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[serviceTargetConfig] = &clientCacheEntry{
+		secret:          secretName,
+		secretKey:       secretKey,
+		resourceVersion: secret.ResourceVersion,
+		client:          kubeClient,
+	}
+	return kubeClient, nil
+}
+
+// Invalidate removes the cached client of a ServiceTargetConfig.
+func (c *ClientCache) Invalidate(serviceTargetConfig types.NamespacedName) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, serviceTargetConfig)
+}
+
+// newClientForKubeconfig creates a client for the cluster of a kubeconfig.
+func newClientForKubeconfig(kubeconfig []byte) (client.Client, error) {
+	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(restConfig, client.Options{})
+}
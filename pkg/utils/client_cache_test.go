@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+var _ = Describe("ClientCache", func() {
+	var (
+		cache   *utils.ClientCache
+		created int
+		secret  *corev1.Secret
+		config  = types.NamespacedName{Name: "config1", Namespace: "laas-system"}
+	)
+
+	BeforeEach(func() {
+		created = 0
+		cache = utils.NewTestClientCache(func(_ []byte) (client.Client, error) {
+			created++
+			return fake.NewClientBuilder().Build(), nil
+		})
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "config1",
+				Namespace:       "laas-system",
+				ResourceVersion: "1",
+			},
+			Data: map[string][]byte{
+				"kubeconfig": []byte("kubeconfig"),
+			},
+		}
+	})
+
+	It("should reuse the client as long as the secret has not changed", func() {
+		c1, err := cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		c2, err := cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c2).To(BeIdenticalTo(c1))
+		Expect(created).To(Equal(1))
+	})
+
+	It("should create a new client when the secret has been rotated", func() {
+		c1, err := cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+
+		secret.ResourceVersion = "2"
+		c2, err := cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c2).ToNot(BeIdenticalTo(c1))
+		Expect(created).To(Equal(2))
+	})
+
+	It("should create a new client after the entry has been invalidated", func() {
+		_, err := cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+
+		cache.Invalidate(config)
+		_, err = cache.GetOrCreate(config, secret, "kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(Equal(2))
+	})
+
+	It("should fail if the secret doesn't contain the key", func() {
+		_, err := cache.GetOrCreate(config, secret, "other")
+		Expect(err).To(HaveOccurred())
+		Expect(created).To(Equal(0))
+	})
+})
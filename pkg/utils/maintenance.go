@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// MaintenanceTimeWindow is a parsed daily maintenance time window.
+type MaintenanceTimeWindow struct {
+	// begin is the beginning of the time window on an arbitrary day.
+	begin time.Time
+	// duration is the length of the time window.
+	duration time.Duration
+}
+
+// ParseMaintenanceTimeWindow parses the begin and end of a maintenance time window.
+// A time window whose end is not after its begin ends on the next day.
+func ParseMaintenanceTimeWindow(timeWindow *lssv1alpha1.MaintenanceTimeWindow) (*MaintenanceTimeWindow, error) {
+	begin, err := time.Parse(lssv1alpha1.MaintenanceTimeWindowLayout, timeWindow.Begin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance time window begin %q: %w", timeWindow.Begin, err)
+	}
+	end, err := time.Parse(lssv1alpha1.MaintenanceTimeWindowLayout, timeWindow.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance time window end %q: %w", timeWindow.End, err)
+	}
+
+	duration := end.Sub(begin)
+	if duration <= 0 {
+		duration += 24 * time.Hour
+	}
+
+	return &MaintenanceTimeWindow{
+		begin:    begin,
+		duration: duration,
+	}, nil
+}
+
+// beginOn returns the beginning of the time window on the day of the given time, in the time zone of the window.
+func (w *MaintenanceTimeWindow) beginOn(t time.Time) time.Time {
+	t = t.In(w.begin.Location())
+	return time.Date(t.Year(), t.Month(), t.Day(), w.begin.Hour(), w.begin.Minute(), w.begin.Second(), 0, w.begin.Location())
+}
+
+// Contains tests whether the given time is inside the time window.
+func (w *MaintenanceTimeWindow) Contains(t time.Time) bool {
+	begin := w.beginOn(t)
+	// the window of the previous day may last until the given time
+	for _, b := range []time.Time{begin, begin.AddDate(0, 0, -1)} {
+		if !t.Before(b) && t.Before(b.Add(w.duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextBegin returns the next beginning of the time window after the given time.
+func (w *MaintenanceTimeWindow) NextBegin(t time.Time) time.Time {
+	begin := w.beginOn(t)
+	if begin.After(t) {
+		return begin
+	}
+	return begin.AddDate(0, 0, 1)
+}
+
+// GetMaintenanceTimeWindow returns the maintenance time window of the instance.
+// If the instance doesn't specify a time window, the time window of the shoot configuration is used.
+// Nil is returned when no time window is configured at all, i.e. changes are applied at any time.
+func GetMaintenanceTimeWindow(instance *lssv1alpha1.Instance, shootTimeWindow config.ShootMaintenanceTimeWindow) (*MaintenanceTimeWindow, error) {
+	timeWindow := instance.Spec.MaintenanceTimeWindow
+	if timeWindow == nil {
+		if len(shootTimeWindow.Begin) == 0 || len(shootTimeWindow.End) == 0 {
+			return nil, nil
+		}
+		timeWindow = &lssv1alpha1.MaintenanceTimeWindow{
+			Begin: shootTimeWindow.Begin,
+			End:   shootTimeWindow.End,
+		}
+	}
+	return ParseMaintenanceTimeWindow(timeWindow)
+}
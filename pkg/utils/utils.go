@@ -5,10 +5,12 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -125,3 +127,26 @@ func GetMapValues[E comparable, F any](m map[E]F) []F {
 	}
 	return values
 }
+
+// GetDataPlaneKubeconfig returns the kubeconfig of an external data plane cluster,
+// either specified inline or read from the referenced secret.
+func GetDataPlaneKubeconfig(ctx context.Context, c client.Client, dataPlane *lssv1alpha1.DataPlane) ([]byte, error) {
+	if len(dataPlane.Kubeconfig) > 0 {
+		return []byte(dataPlane.Kubeconfig), nil
+	}
+
+	if dataPlane.SecretRef == nil {
+		return nil, fmt.Errorf("data plane has neither a kubeconfig nor a secret reference")
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, dataPlane.SecretRef.NamespacedName(), secret); err != nil {
+		return nil, fmt.Errorf("unable to get kubeconfig secret for data plane: %w", err)
+	}
+
+	val, ok := secret.Data[dataPlane.SecretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("unable to read kubeconfig from secret: missing key %q", dataPlane.SecretRef.Key)
+	}
+	return val, nil
+}
@@ -5,12 +5,14 @@
 package utils_test
 
 import (
+	"context"
 	"encoding/json"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/utils"
@@ -133,4 +135,29 @@ var _ = Describe("Utils", func() {
 		Expect(secret.ObjectMeta.Annotations).ToNot(HaveKeyWithValue(lssv1alpha1.LandscaperServiceOperationAnnotation, lssv1alpha1.LandscaperServiceOperationIgnore))
 		Expect(secret.ObjectMeta.Annotations).To(HaveKeyWithValue("someKey", "someVar"))
 	})
+
+	It("should read the kubeconfig of a data plane", func() {
+		ctx := context.Background()
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "dataplane", Namespace: "test"},
+			Data:       map[string][]byte{"kubeconfig": []byte("from-secret")},
+		}
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+
+		kubeconfig, err := utils.GetDataPlaneKubeconfig(ctx, c, &lssv1alpha1.DataPlane{Kubeconfig: "inline"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("inline"))
+
+		secretRef := &lssv1alpha1.SecretReference{
+			ObjectReference: lssv1alpha1.ObjectReference{Name: "dataplane", Namespace: "test"},
+			Key:             "kubeconfig",
+		}
+		kubeconfig, err = utils.GetDataPlaneKubeconfig(ctx, c, &lssv1alpha1.DataPlane{SecretRef: secretRef})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("from-secret"))
+
+		secretRef.Key = "missing"
+		_, err = utils.GetDataPlaneKubeconfig(ctx, c, &lssv1alpha1.DataPlane{SecretRef: secretRef})
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -19,6 +19,8 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 )
 
 // WebhookedResourceDefinition contains information about the resources that should be watched by the webhook
@@ -51,6 +53,8 @@ type Options struct {
 	WebhookedResources []WebhookedResourceDefinition
 	// certificates for the webhook
 	CABundle []byte
+	// the landscaper instance versions which are supported by the landscaper service
+	SupportedVersions versions.SupportedVersions
 }
 
 // UpdateValidatingWebhookConfiguration will create or update a ValidatingWebhookConfiguration
@@ -150,7 +154,7 @@ func RegisterWebhooks(ctx context.Context, webhookServer ctrlwebhook.Server, cli
 	// registering webhooks
 	for _, elem := range o.WebhookedResources {
 		rsLogger := logger.WithName(elem.ResourceName)
-		val, err := ValidatorFromResourceType(rsLogger, client, scheme, elem.ResourceName)
+		val, err := ValidatorFromResourceType(rsLogger, client, scheme, o.SupportedVersions, elem.ResourceName)
 		if err != nil {
 			return fmt.Errorf("unable to register webhooks: %w", err)
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 	"github.com/gardener/landscaper-service/pkg/webhook"
 	"github.com/gardener/landscaper-service/test/utils/envtest"
 )
@@ -41,7 +42,7 @@ var _ = Describe("Instance", func() {
 
 	BeforeEach(func() {
 		var err error
-		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.InstancesResourceType)
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, nil, webhook.InstancesResourceType)
 		Expect(err).ToNot(HaveOccurred())
 
 		ctx = context.Background()
@@ -187,6 +188,47 @@ var _ = Describe("Instance", func() {
 		Expect(response.Allowed).To(BeTrue())
 	})
 
+	It("should validate the landscaper version against the supported versions", func() {
+		supportedVersions, err := versions.ParseSupportedVersions([]string{"v0.1.0", "v0.2.1", "v0.3.0"})
+		Expect(err).ToNot(HaveOccurred())
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, supportedVersions, webhook.InstancesResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		testObj := createInstance("test", "lss-system")
+
+		testObj.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "test",
+				Namespace: "lss-system",
+			},
+			LandscaperVersion: "v0.1.0",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		oldObject := testObj.DeepCopy()
+		oldObject.Status.LandscaperServiceComponent = &lssv1alpha1.LandscaperServiceComponent{Version: "v0.1.0"}
+		testObj.Spec.LandscaperVersion = "v0.3.0"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObject)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.landscaperVersion"))
+
+		testObj.Spec.LandscaperVersion = "v0.2.1"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObject)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
 	It("should deny an update of the tenant id", func() {
 		testObj := createInstance("test", "lss-system")
 
@@ -283,6 +325,89 @@ var _ = Describe("Instance", func() {
 		Expect(response.Allowed).To(BeFalse())
 	})
 
+	It("should deny an update of the service target config ref if only the migration annotation is set", func() {
+		testObj := createInstance("test", "lss-system")
+
+		testObj.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "test",
+				Namespace: "lss-system",
+			},
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+		}
+
+		oldObject := testObj.DeepCopy()
+		testObj.Annotations = map[string]string{lssv1alpha1.InstanceMigrationAnnotation: "lss-system/test1"}
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		oldObject = testObj.DeepCopy()
+		testObj.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{
+			Name:      "test1",
+			Namespace: "lss-system",
+		}
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObject)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.serviceTargetConfigRef"))
+	})
+
+	It("should allow the switch of the service target config ref by a running migration", func() {
+		testObj := createInstance("test", "lss-system")
+
+		testObj.Annotations = map[string]string{lssv1alpha1.InstanceMigrationAnnotation: "lss-system/test1"}
+		testObj.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "test",
+				Namespace: "lss-system",
+			},
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+		}
+		testObj.Status.Migration = &lssv1alpha1.InstanceMigrationStatus{
+			Phase:                        lssv1alpha1.InstanceMigrationPhaseDeletingTarget,
+			SourceServiceTargetConfigRef: testObj.Spec.ServiceTargetConfigRef,
+			TargetServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: "test1", Namespace: "lss-system"},
+		}
+
+		// the service target config ref must not be changed before the migration switches it
+		oldObject := testObj.DeepCopy()
+		testObj.Spec.ServiceTargetConfigRef = testObj.Status.Migration.TargetServiceTargetConfigRef
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+
+		oldObject.Status.Migration.Phase = lssv1alpha1.InstanceMigrationPhaseSwitchingServiceTargetConfig
+		request = CreateAdmissionRequestUpdate(testObj, oldObject)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		// only the target of the migration is allowed
+		testObj.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{Name: "test2", Namespace: "lss-system"}
+		request = CreateAdmissionRequestUpdate(testObj, oldObject)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+	})
+
 	It("should validate high availability config", func() {
 		testObj := createInstance("test", "lss-system")
 		testObj.Spec = lssv1alpha1.InstanceSpec{
@@ -41,7 +41,7 @@ var _ = Describe("LandscaperDeployment", func() {
 
 	BeforeEach(func() {
 		var err error
-		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.LandscaperDeploymentsResourceType)
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, nil, webhook.LandscaperDeploymentsResourceType)
 		Expect(err).ToNot(HaveOccurred())
 
 		ctx = context.Background()
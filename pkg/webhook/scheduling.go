@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+const (
+	// SchedulingExplanationPath is the path under which the scheduling explanation handler is registered.
+	SchedulingExplanationPath = "/scheduling/explain"
+
+	// maxSchedulingExplanationRequestSize is the maximum size of a LandscaperDeployment manifest.
+	maxSchedulingExplanationRequestSize = 1 << 20
+)
+
+// SchedulingExplanationResponse is the response of the scheduling explanation handler.
+type SchedulingExplanationResponse struct {
+	// Explanation explains on which ServiceTargetConfig the LandscaperDeployment would be scheduled.
+	Explanation *lssv1alpha1.SchedulingExplanation `json:"explanation,omitempty"`
+	// Error is set if the LandscaperDeployment can't be scheduled.
+	Error string `json:"error,omitempty"`
+}
+
+// RequestAuthorizer authorizes the requests to the scheduling explanation handler.
+type RequestAuthorizer interface {
+	// Authorize returns the http status code and the reason if the request is not authorized.
+	Authorize(ctx context.Context, request *http.Request) (int, error)
+}
+
+// SubjectAccessReviewAuthorizer authenticates the bearer token of a request with a TokenReview
+// and authorizes its user with a SubjectAccessReview.
+// The user must be allowed to list ServiceTargetConfigs, as the scheduling explanation reveals
+// the ServiceTargetConfigs with their capacity and usage and the tenant IDs of the instances.
+type SubjectAccessReviewAuthorizer struct {
+	Client client.Client
+}
+
+// Authorize implements RequestAuthorizer.
+func (a *SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, request *http.Request) (int, error) {
+	token, ok := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if !ok || len(token) == 0 {
+		return http.StatusUnauthorized, fmt.Errorf("missing bearer token")
+	}
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.Client.Create(ctx, tokenReview); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("unable to review token: %w", err)
+	}
+	if !tokenReview.Status.Authenticated {
+		return http.StatusUnauthorized, fmt.Errorf("invalid bearer token")
+	}
+
+	user := tokenReview.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	accessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    lssv1alpha1.SchemeGroupVersion.Group,
+				Resource: "servicetargetconfigs",
+				Verb:     "list",
+			},
+		},
+	}
+	if err := a.Client.Create(ctx, accessReview); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("unable to review access: %w", err)
+	}
+	if !accessReview.Status.Allowed {
+		return http.StatusForbidden, fmt.Errorf("user %q is not allowed to list servicetargetconfigs", user.Username)
+	}
+	return http.StatusOK, nil
+}
+
+// SchedulingExplanationHandler does a dry-run of the scheduling of a LandscaperDeployment.
+// It accepts a LandscaperDeployment manifest in json or yaml format and responds with the scheduling explanation.
+type SchedulingExplanationHandler struct {
+	Client        client.Client
+	authorizer    RequestAuthorizer
+	decoder       runtime.Decoder
+	log           logging.Logger
+	schedulingKey *client.ObjectKey
+}
+
+// NewSchedulingExplanationHandler creates a new scheduling explanation handler.
+// The scheduling key references the TargetScheduling resource, it is nil if scheduling is not configured.
+func NewSchedulingExplanationHandler(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, schedulingKey *client.ObjectKey) *SchedulingExplanationHandler {
+	return &SchedulingExplanationHandler{
+		Client:        kubeClient,
+		authorizer:    &SubjectAccessReviewAuthorizer{Client: kubeClient},
+		decoder:       serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+		log:           log,
+		schedulingKey: schedulingKey,
+	}
+}
+
+// WithAuthorizer sets the authorizer of the requests.
+func (h *SchedulingExplanationHandler) WithAuthorizer(authorizer RequestAuthorizer) *SchedulingExplanationHandler {
+	h.authorizer = authorizer
+	return h
+}
+
+// ServeHTTP handles a request to the scheduling explanation handler
+func (h *SchedulingExplanationHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if status, err := h.authorizer.Authorize(request.Context(), request); err != nil {
+		if status == http.StatusInternalServerError {
+			h.log.Error(err, "unable to authorize scheduling explanation request")
+		}
+		http.Error(writer, err.Error(), status)
+		return
+	}
+
+	if request.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, fmt.Sprintf("method %s not allowed", request.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(request.Body, maxSchedulingExplanationRequestSize))
+	if err != nil {
+		http.Error(writer, fmt.Sprintf("unable to read request: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if _, _, err := h.decoder.Decode(data, nil, deployment); err != nil {
+		http.Error(writer, fmt.Sprintf("unable to decode landscaper deployment: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	_, explanation, err := lssscheduling.ExplainScheduling(request.Context(), h.Client, h.schedulingKey, deployment)
+	if err != nil && explanation == nil {
+		h.log.Error(err, "unable to explain scheduling")
+		http.Error(writer, fmt.Sprintf("unable to explain scheduling: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	response := SchedulingExplanationResponse{
+		Explanation: explanation,
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		h.log.Error(err, "unable to send scheduling explanation")
+	}
+}
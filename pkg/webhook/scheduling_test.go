@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+const schedulingExplanationDeployment = `
+apiVersion: landscaper-service.gardener.cloud/v1alpha1
+kind: LandscaperDeployment
+metadata:
+  name: test
+  namespace: test
+spec:
+  tenantId: "12345"
+  purpose: test
+`
+
+// allowAllAuthorizer authorizes every request.
+type allowAllAuthorizer struct{}
+
+func (a *allowAllAuthorizer) Authorize(_ context.Context, _ *http.Request) (int, error) {
+	return http.StatusOK, nil
+}
+
+var _ = Describe("SchedulingExplanation", func() {
+	var (
+		handler *webhook.SchedulingExplanationHandler
+		ctx     context.Context
+		state   *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		handler = webhook.NewSchedulingExplanationHandler(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, nil).
+			WithAuthorizer(&allowAllAuthorizer{})
+	})
+
+	AfterEach(func() {
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).To(Succeed())
+			state = nil
+		}
+	})
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, webhook.SchedulingExplanationPath, strings.NewReader(body)))
+		return recorder
+	}
+
+	It("should explain the scheduling of a landscaper deployment", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/scheduling")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := serve(http.MethodPost, schedulingExplanationDeployment)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		response := &webhook.SchedulingExplanationResponse{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+		Expect(response.Error).To(BeEmpty())
+		Expect(response.Explanation).ToNot(BeNil())
+		Expect(response.Explanation.Unrestricted).To(BeTrue())
+		Expect(response.Explanation.Selected).To(Equal(&lssv1alpha1.ObjectReference{Name: "config1", Namespace: state.Namespace}))
+		Expect(response.Explanation.FilteredOut).To(ContainElement(lssv1alpha1.FilteredServiceTargetConfig{
+			ObjectReference: lssv1alpha1.ObjectReference{Name: "config2", Namespace: state.Namespace},
+			Reason:          lssv1alpha1.SchedulingReasonRestricted,
+			Message:         "no scheduling rule matched and the service target config is restricted",
+		}))
+	})
+
+	It("should reject an invalid manifest", func() {
+		recorder := serve(http.MethodPost, "invalid")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should only accept post requests", func() {
+		recorder := serve(http.MethodGet, "")
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
+
+var _ = Describe("SubjectAccessReviewAuthorizer", func() {
+	var (
+		authorizer  *webhook.SubjectAccessReviewAuthorizer
+		accessSpec  *authorizationv1.SubjectAccessReviewSpec
+		allowedUser string
+	)
+
+	BeforeEach(func() {
+		allowedUser = "operator"
+		accessSpec = nil
+		kubeClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				switch review := obj.(type) {
+				case *authenticationv1.TokenReview:
+					if review.Spec.Token == "valid" {
+						review.Status.Authenticated = true
+						review.Status.User = authenticationv1.UserInfo{Username: "operator", Groups: []string{"operators"}}
+					}
+				case *authorizationv1.SubjectAccessReview:
+					accessSpec = review.Spec.DeepCopy()
+					review.Status.Allowed = review.Spec.User == allowedUser
+				}
+				return nil
+			},
+		}).Build()
+		authorizer = &webhook.SubjectAccessReviewAuthorizer{Client: kubeClient}
+	})
+
+	authorize := func(token string) int {
+		request := httptest.NewRequest(http.MethodPost, webhook.SchedulingExplanationPath, nil)
+		if len(token) > 0 {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		status, _ := authorizer.Authorize(context.Background(), request)
+		return status
+	}
+
+	It("should authorize a user who is allowed to list service target configs", func() {
+		Expect(authorize("valid")).To(Equal(http.StatusOK))
+		Expect(accessSpec.User).To(Equal("operator"))
+		Expect(accessSpec.Groups).To(ConsistOf("operators"))
+		Expect(accessSpec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+			Group:    lssv1alpha1.SchemeGroupVersion.Group,
+			Resource: "servicetargetconfigs",
+			Verb:     "list",
+		}))
+	})
+
+	It("should reject a request without a valid bearer token", func() {
+		Expect(authorize("")).To(Equal(http.StatusUnauthorized))
+		Expect(authorize("invalid")).To(Equal(http.StatusUnauthorized))
+		Expect(accessSpec).To(BeNil())
+	})
+
+	It("should reject a user who is not allowed to list service target configs", func() {
+		allowedUser = "admin"
+		Expect(authorize("valid")).To(Equal(http.StatusForbidden))
+	})
+
+	It("should not explain the scheduling of an unauthorized request", func() {
+		handler := webhook.NewSchedulingExplanationHandler(logging.Discard(), authorizer.Client, envtest.LandscaperServiceScheme, nil)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, webhook.SchedulingExplanationPath,
+			strings.NewReader(schedulingExplanationDeployment)))
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
@@ -41,7 +42,7 @@ var _ = Describe("ServiceTargetConfig", func() {
 
 	BeforeEach(func() {
 		var err error
-		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.ServiceTargetConfigsResourceType)
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, nil, webhook.ServiceTargetConfigsResourceType)
 		Expect(err).ToNot(HaveOccurred())
 
 		ctx = context.Background()
@@ -180,4 +181,69 @@ var _ = Describe("ServiceTargetConfig", func() {
 
 		Expect(response.Result.Message).To(ContainSubstring("spec.ingressDomain"))
 	})
+
+	It("should deny resource with a zone but without region", func() {
+		testObj := createServiceTargetConfig("test", "lss-system")
+
+		testObj.Labels = map[string]string{
+			lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true",
+		}
+		testObj.Spec = lssv1alpha1.ServiceTargetConfigSpec{
+			Priority: 10,
+			SecretRef: lssv1alpha1.SecretReference{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      "target",
+					Namespace: "lss-system",
+				},
+				Key: "kubeconfig",
+			},
+			IngressDomain: "ingress.mycluster.external",
+			Topology: &lssv1alpha1.Topology{
+				Zone: "eu-west-1a",
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result).ToNot(BeNil())
+		Expect(response.Result.Message).ToNot(BeNil())
+
+		Expect(response.Result.Message).To(ContainSubstring("spec.topology.region"))
+	})
+
+	It("should deny resource with a cordon without reason", func() {
+		testObj := createServiceTargetConfig("test", "lss-system")
+
+		testObj.Labels = map[string]string{
+			lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true",
+		}
+		testObj.Spec = lssv1alpha1.ServiceTargetConfigSpec{
+			Priority: 10,
+			SecretRef: lssv1alpha1.SecretReference{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      "target",
+					Namespace: "lss-system",
+				},
+				Key: "kubeconfig",
+			},
+			IngressDomain: "ingress.mycluster.external",
+			Cordon: &lssv1alpha1.Cordon{
+				Drain: &lssv1alpha1.Drain{
+					MaxConcurrentMigrations: ptr.To[int32](0),
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result).ToNot(BeNil())
+		Expect(response.Result.Message).ToNot(BeNil())
+
+		Expect(response.Result.Message).To(ContainSubstring("spec.cordon.reason"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.cordon.drain.maxConcurrentMigrations"))
+	})
 })
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/webhook"
@@ -38,7 +39,7 @@ var _ = Describe("TargetScheduling", func() {
 
 	BeforeEach(func() {
 		var err error
-		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.TargetSchedulingsResourceType)
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, nil, webhook.TargetSchedulingsResourceType)
 		Expect(err).ToNot(HaveOccurred())
 
 		ctx = context.Background()
@@ -204,4 +205,174 @@ var _ = Describe("TargetScheduling", func() {
 		expectErrorAtPath(testObj, "spec.rules[0].selector[0].or[0].and[0].not.or[0]")
 	})
 
+	It("should allow a valid spread policy", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Spread: &lssv1alpha1.SpreadPolicy{
+					Mode:      lssv1alpha1.SpreadModeHard,
+					LabelName: "landscaper-service.gardener.cloud/project",
+					MaxSkew:   ptr.To[int64](2),
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny a spread policy with an unsupported mode", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Spread: &lssv1alpha1.SpreadPolicy{Mode: "Sometimes"},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].spread.mode")
+	})
+
+	It("should deny a soft spread policy with a maximum skew", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Spread: &lssv1alpha1.SpreadPolicy{MaxSkew: ptr.To[int64](2)},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].spread.maxSkew")
+	})
+
+	It("should allow selectors with operators", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchTenant: &lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpIn, Values: []string{"test-tenant-1", "test-tenant-2"}}},
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "tier", Operator: lssv1alpha1.SelectorOpExists}},
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "region", Operator: lssv1alpha1.SelectorOpPrefix, Values: []string{"eu-"}}},
+					{MatchPurpose: &lssv1alpha1.PurposeSelector{Operator: lssv1alpha1.SelectorOpRegex, Values: []string{"prod(uction)?"}}},
+					{MatchExpression: `object.spec.purpose == "prod" && object.metadata.labels["region"].startsWith("eu")`},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny a tenant selector with the operator Exists", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchTenant: &lssv1alpha1.TenantSelector{Operator: lssv1alpha1.SelectorOpExists}},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchTenant.operator")
+	})
+
+	It("should deny a label selector with operator In without values", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "tier", Operator: lssv1alpha1.SelectorOpIn}},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchLabel.values")
+	})
+
+	It("should deny a purpose selector with an invalid regular expression", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchPurpose: &lssv1alpha1.PurposeSelector{Operator: lssv1alpha1.SelectorOpRegex, Values: []string{"prod("}}},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchPurpose.values[0]")
+	})
+
+	It("should deny an expression selector which doesn't compile", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchExpression: `object.spec.purpose == `},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchExpression")
+	})
+
+	It("should deny an expression selector which accesses an unknown field", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchExpression: `object.spec.purpsoe == "prod"`},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchExpression")
+	})
+
+	It("should deny an expression selector which doesn't evaluate to bool", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchExpression: `size(object.spec.tenantId)`},
+				},
+			},
+		}
+		expectErrorAtPath(testObj, "spec.rules[0].selector[0].matchExpression")
+	})
+
 })
@@ -19,6 +19,7 @@ import (
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/validation"
+	"github.com/gardener/landscaper-service/pkg/apis/versions"
 )
 
 const (
@@ -29,8 +30,8 @@ const (
 )
 
 // ValidatorFromResourceType is a helper method that gets a resource type and returns the fitting validator
-func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, resource string) (GenericValidator, error) {
-	abstrVal := newAbstractedValidator(log, kubeClient, scheme)
+func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, supportedVersions versions.SupportedVersions, resource string) (GenericValidator, error) {
+	abstrVal := newAbstractedValidator(log, kubeClient, scheme, supportedVersions)
 	var val GenericValidator
 	switch resource {
 	case LandscaperDeploymentsResourceType:
@@ -48,17 +49,19 @@ func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, sch
 }
 
 type abstractValidator struct {
-	Client  client.Client
-	decoder runtime.Decoder
-	log     logging.Logger
+	Client            client.Client
+	decoder           runtime.Decoder
+	log               logging.Logger
+	supportedVersions versions.SupportedVersions
 }
 
 // newAbstractedValidator creates a new abstracted validator
-func newAbstractedValidator(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme) abstractValidator {
+func newAbstractedValidator(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, supportedVersions versions.SupportedVersions) abstractValidator {
 	return abstractValidator{
-		Client:  kubeClient,
-		decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
-		log:     log,
+		Client:            kubeClient,
+		decoder:           serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		log:               log,
+		supportedVersions: supportedVersions,
 	}
 }
 
@@ -87,7 +90,7 @@ func (dv *LandscaperDeploymentValidator) Handle(_ context.Context, req admission
 		}
 	}
 
-	if errs := validation.ValidateLandscaperDeployment(deployment, oldDeployment); len(errs) > 0 {
+	if errs := validation.ValidateLandscaperDeployment(deployment, oldDeployment, dv.supportedVersions); len(errs) > 0 {
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
@@ -114,7 +117,7 @@ func (iv *InstanceValidator) Handle(_ context.Context, req admission.Request) ad
 		}
 	}
 
-	if errs := validation.ValidateInstance(instance, oldInstance); len(errs) > 0 {
+	if errs := validation.ValidateInstance(instance, oldInstance, iv.supportedVersions); len(errs) > 0 {
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
@@ -51,8 +51,9 @@ func ShouldNotReconcile(ctx context.Context, reconciler reconcile.Reconciler, re
 func DefaultControllerConfiguration() *config.LandscaperServiceConfiguration {
 	cfg := &config.LandscaperServiceConfiguration{
 		LandscaperServiceComponent: config.LandscaperServiceComponentConfiguration{
-			Name:    "github.com/gardener/landscaper-service/landscaper-instance",
-			Version: "v1.1.1",
+			Name:              "github.com/gardener/landscaper-service/landscaper-instance",
+			Version:           "v1.1.1",
+			DeprecationPeriod: v1alpha1.Duration{Duration: time.Hour * 24 * 90},
 		},
 		AvailabilityMonitoring: config.AvailabilityMonitoringConfiguration{
 			AvailabilityCollectionName:      "availability",
@@ -60,6 +61,17 @@ func DefaultControllerConfiguration() *config.LandscaperServiceConfiguration {
 			SelfLandscaperNamespace:         "landscaper",
 			PeriodicCheckInterval:           v1alpha1.Duration{Duration: time.Minute * 1},
 			LSHealthCheckTimeout:            v1alpha1.Duration{Duration: time.Minute * 5},
+			CheckConcurrency:                10,
+			InstanceCheckTimeout:            v1alpha1.Duration{Duration: time.Second * 30},
+			DataPlaneProbeTimeout:           v1alpha1.Duration{Duration: time.Second * 10},
+		},
+		ServiceTargetConfigProbe: config.ServiceTargetConfigProbeConfiguration{
+			PeriodicProbeInterval: v1alpha1.Duration{Duration: time.Minute * 1},
+			ProbeTimeout:          v1alpha1.Duration{Duration: time.Second * 10},
+		},
+		InstanceKubeconfigs: config.InstanceKubeconfigsConfiguration{
+			UserKubeconfigSecretNameSuffix:  "user-kubeconfig",
+			AdminKubeconfigSecretNameSuffix: "admin-kubeconfig",
 		},
 		GardenerConfiguration: config.GardenerConfiguration{
 			ShootSecretBindingName: "secret-binding",